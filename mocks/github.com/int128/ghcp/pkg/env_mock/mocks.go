@@ -5,6 +5,8 @@
 package env_mock
 
 import (
+	"io"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -35,6 +37,63 @@ func (_m *MockInterface) EXPECT() *MockInterface_Expecter {
 	return &MockInterface_Expecter{mock: &_m.Mock}
 }
 
+// AppendFile provides a mock function for the type MockInterface
+func (_mock *MockInterface) AppendFile(name string, data []byte) error {
+	ret := _mock.Called(name, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AppendFile")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, []byte) error); ok {
+		r0 = returnFunc(name, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockInterface_AppendFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppendFile'
+type MockInterface_AppendFile_Call struct {
+	*mock.Call
+}
+
+// AppendFile is a helper method to define mock.On call
+//   - name string
+//   - data []byte
+func (_e *MockInterface_Expecter) AppendFile(name any, data any) *MockInterface_AppendFile_Call {
+	return &MockInterface_AppendFile_Call{Call: _e.mock.On("AppendFile", name, data)}
+}
+
+func (_c *MockInterface_AppendFile_Call) Run(run func(name string, data []byte)) *MockInterface_AppendFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 []byte
+		if args[1] != nil {
+			arg1 = args[1].([]byte)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInterface_AppendFile_Call) Return(err error) *MockInterface_AppendFile_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockInterface_AppendFile_Call) RunAndReturn(run func(name string, data []byte) error) *MockInterface_AppendFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Chdir provides a mock function for the type MockInterface
 func (_mock *MockInterface) Chdir(dir string) error {
 	ret := _mock.Called(dir)
@@ -136,3 +195,49 @@ func (_c *MockInterface_Getenv_Call) RunAndReturn(run func(key string) string) *
 	_c.Call.Return(run)
 	return _c
 }
+
+// Stdout provides a mock function for the type MockInterface
+func (_mock *MockInterface) Stdout() io.Writer {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stdout")
+	}
+
+	var r0 io.Writer
+	if returnFunc, ok := ret.Get(0).(func() io.Writer); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.Writer)
+		}
+	}
+	return r0
+}
+
+// MockInterface_Stdout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stdout'
+type MockInterface_Stdout_Call struct {
+	*mock.Call
+}
+
+// Stdout is a helper method to define mock.On call
+func (_e *MockInterface_Expecter) Stdout() *MockInterface_Stdout_Call {
+	return &MockInterface_Stdout_Call{Call: _e.mock.On("Stdout")}
+}
+
+func (_c *MockInterface_Stdout_Call) Run(run func()) *MockInterface_Stdout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockInterface_Stdout_Call) Return(writer io.Writer) *MockInterface_Stdout_Call {
+	_c.Call.Return(writer)
+	return _c
+}
+
+func (_c *MockInterface_Stdout_Call) RunAndReturn(run func() io.Writer) *MockInterface_Stdout_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -5,6 +5,8 @@
 package fs_mock
 
 import (
+	"io"
+
 	"github.com/int128/ghcp/pkg/fs"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -36,6 +38,126 @@ func (_m *MockInterface) EXPECT() *MockInterface_Expecter {
 	return &MockInterface_Expecter{mock: &_m.Mock}
 }
 
+// ComputeGitBlobSHA provides a mock function for the type MockInterface
+func (_mock *MockInterface) ComputeGitBlobSHA(filename string) (string, error) {
+	ret := _mock.Called(filename)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ComputeGitBlobSHA")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(filename)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(filename)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(filename)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInterface_ComputeGitBlobSHA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ComputeGitBlobSHA'
+type MockInterface_ComputeGitBlobSHA_Call struct {
+	*mock.Call
+}
+
+// ComputeGitBlobSHA is a helper method to define mock.On call
+//   - filename string
+func (_e *MockInterface_Expecter) ComputeGitBlobSHA(filename any) *MockInterface_ComputeGitBlobSHA_Call {
+	return &MockInterface_ComputeGitBlobSHA_Call{Call: _e.mock.On("ComputeGitBlobSHA", filename)}
+}
+
+func (_c *MockInterface_ComputeGitBlobSHA_Call) Run(run func(filename string)) *MockInterface_ComputeGitBlobSHA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInterface_ComputeGitBlobSHA_Call) Return(s string, err error) *MockInterface_ComputeGitBlobSHA_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockInterface_ComputeGitBlobSHA_Call) RunAndReturn(run func(filename string) (string, error)) *MockInterface_ComputeGitBlobSHA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ComputeSHA256 provides a mock function for the type MockInterface
+func (_mock *MockInterface) ComputeSHA256(filename string) (string, error) {
+	ret := _mock.Called(filename)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ComputeSHA256")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(filename)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(filename)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(filename)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInterface_ComputeSHA256_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ComputeSHA256'
+type MockInterface_ComputeSHA256_Call struct {
+	*mock.Call
+}
+
+// ComputeSHA256 is a helper method to define mock.On call
+//   - filename string
+func (_e *MockInterface_Expecter) ComputeSHA256(filename any) *MockInterface_ComputeSHA256_Call {
+	return &MockInterface_ComputeSHA256_Call{Call: _e.mock.On("ComputeSHA256", filename)}
+}
+
+func (_c *MockInterface_ComputeSHA256_Call) Run(run func(filename string)) *MockInterface_ComputeSHA256_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInterface_ComputeSHA256_Call) Return(s string, err error) *MockInterface_ComputeSHA256_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockInterface_ComputeSHA256_Call) RunAndReturn(run func(filename string) (string, error)) *MockInterface_ComputeSHA256_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindFiles provides a mock function for the type MockInterface
 func (_mock *MockInterface) FindFiles(paths []string, filter fs.FindFilesFilter) ([]fs.File, error) {
 	ret := _mock.Called(paths, filter)
@@ -104,23 +226,80 @@ func (_c *MockInterface_FindFiles_Call) RunAndReturn(run func(paths []string, fi
 	return _c
 }
 
-// ReadAsBase64EncodedContent provides a mock function for the type MockInterface
-func (_mock *MockInterface) ReadAsBase64EncodedContent(filename string) (string, error) {
+// NewGitIgnoreFilter provides a mock function for the type MockInterface
+func (_mock *MockInterface) NewGitIgnoreFilter() (fs.FindFilesFilter, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for NewGitIgnoreFilter")
+	}
+
+	var r0 fs.FindFilesFilter
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (fs.FindFilesFilter, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() fs.FindFilesFilter); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(fs.FindFilesFilter)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInterface_NewGitIgnoreFilter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NewGitIgnoreFilter'
+type MockInterface_NewGitIgnoreFilter_Call struct {
+	*mock.Call
+}
+
+// NewGitIgnoreFilter is a helper method to define mock.On call
+func (_e *MockInterface_Expecter) NewGitIgnoreFilter() *MockInterface_NewGitIgnoreFilter_Call {
+	return &MockInterface_NewGitIgnoreFilter_Call{Call: _e.mock.On("NewGitIgnoreFilter")}
+}
+
+func (_c *MockInterface_NewGitIgnoreFilter_Call) Run(run func()) *MockInterface_NewGitIgnoreFilter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockInterface_NewGitIgnoreFilter_Call) Return(findFilesFilter fs.FindFilesFilter, err error) *MockInterface_NewGitIgnoreFilter_Call {
+	_c.Call.Return(findFilesFilter, err)
+	return _c
+}
+
+func (_c *MockInterface_NewGitIgnoreFilter_Call) RunAndReturn(run func() (fs.FindFilesFilter, error)) *MockInterface_NewGitIgnoreFilter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Open provides a mock function for the type MockInterface
+func (_mock *MockInterface) Open(filename string) (io.ReadCloser, error) {
 	ret := _mock.Called(filename)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReadAsBase64EncodedContent")
+		panic("no return value specified for Open")
 	}
 
-	var r0 string
+	var r0 io.ReadCloser
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(string) (io.ReadCloser, error)); ok {
 		return returnFunc(filename)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+	if returnFunc, ok := ret.Get(0).(func(string) io.ReadCloser); ok {
 		r0 = returnFunc(filename)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
 		r1 = returnFunc(filename)
@@ -130,18 +309,18 @@ func (_mock *MockInterface) ReadAsBase64EncodedContent(filename string) (string,
 	return r0, r1
 }
 
-// MockInterface_ReadAsBase64EncodedContent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadAsBase64EncodedContent'
-type MockInterface_ReadAsBase64EncodedContent_Call struct {
+// MockInterface_Open_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Open'
+type MockInterface_Open_Call struct {
 	*mock.Call
 }
 
-// ReadAsBase64EncodedContent is a helper method to define mock.On call
+// Open is a helper method to define mock.On call
 //   - filename string
-func (_e *MockInterface_Expecter) ReadAsBase64EncodedContent(filename any) *MockInterface_ReadAsBase64EncodedContent_Call {
-	return &MockInterface_ReadAsBase64EncodedContent_Call{Call: _e.mock.On("ReadAsBase64EncodedContent", filename)}
+func (_e *MockInterface_Expecter) Open(filename any) *MockInterface_Open_Call {
+	return &MockInterface_Open_Call{Call: _e.mock.On("Open", filename)}
 }
 
-func (_c *MockInterface_ReadAsBase64EncodedContent_Call) Run(run func(filename string)) *MockInterface_ReadAsBase64EncodedContent_Call {
+func (_c *MockInterface_Open_Call) Run(run func(filename string)) *MockInterface_Open_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
@@ -154,12 +333,292 @@ func (_c *MockInterface_ReadAsBase64EncodedContent_Call) Run(run func(filename s
 	return _c
 }
 
-func (_c *MockInterface_ReadAsBase64EncodedContent_Call) Return(s string, err error) *MockInterface_ReadAsBase64EncodedContent_Call {
-	_c.Call.Return(s, err)
+func (_c *MockInterface_Open_Call) Return(readCloser io.ReadCloser, err error) *MockInterface_Open_Call {
+	_c.Call.Return(readCloser, err)
+	return _c
+}
+
+func (_c *MockInterface_Open_Call) RunAndReturn(run func(filename string) (io.ReadCloser, error)) *MockInterface_Open_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadFile provides a mock function for the type MockInterface
+func (_mock *MockInterface) ReadFile(filename string) ([]byte, error) {
+	ret := _mock.Called(filename)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadFile")
+	}
+
+	var r0 []byte
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) ([]byte, error)); ok {
+		return returnFunc(filename)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) []byte); ok {
+		r0 = returnFunc(filename)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(filename)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInterface_ReadFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadFile'
+type MockInterface_ReadFile_Call struct {
+	*mock.Call
+}
+
+// ReadFile is a helper method to define mock.On call
+//   - filename string
+func (_e *MockInterface_Expecter) ReadFile(filename any) *MockInterface_ReadFile_Call {
+	return &MockInterface_ReadFile_Call{Call: _e.mock.On("ReadFile", filename)}
+}
+
+func (_c *MockInterface_ReadFile_Call) Run(run func(filename string)) *MockInterface_ReadFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInterface_ReadFile_Call) Return(bytes []byte, err error) *MockInterface_ReadFile_Call {
+	_c.Call.Return(bytes, err)
+	return _c
+}
+
+func (_c *MockInterface_ReadFile_Call) RunAndReturn(run func(filename string) ([]byte, error)) *MockInterface_ReadFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadGitConfig provides a mock function for the type MockInterface
+func (_mock *MockInterface) ReadGitConfig() (*fs.GitConfig, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadGitConfig")
+	}
+
+	var r0 *fs.GitConfig
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (*fs.GitConfig, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() *fs.GitConfig); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*fs.GitConfig)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInterface_ReadGitConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadGitConfig'
+type MockInterface_ReadGitConfig_Call struct {
+	*mock.Call
+}
+
+// ReadGitConfig is a helper method to define mock.On call
+func (_e *MockInterface_Expecter) ReadGitConfig() *MockInterface_ReadGitConfig_Call {
+	return &MockInterface_ReadGitConfig_Call{Call: _e.mock.On("ReadGitConfig")}
+}
+
+func (_c *MockInterface_ReadGitConfig_Call) Run(run func()) *MockInterface_ReadGitConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockInterface_ReadGitConfig_Call) Return(gitConfig *fs.GitConfig, err error) *MockInterface_ReadGitConfig_Call {
+	_c.Call.Return(gitConfig, err)
+	return _c
+}
+
+func (_c *MockInterface_ReadGitConfig_Call) RunAndReturn(run func() (*fs.GitConfig, error)) *MockInterface_ReadGitConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadGitWorkingTree provides a mock function for the type MockInterface
+func (_mock *MockInterface) ReadGitWorkingTree() (*fs.GitWorkingTree, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadGitWorkingTree")
+	}
+
+	var r0 *fs.GitWorkingTree
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (*fs.GitWorkingTree, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() *fs.GitWorkingTree); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*fs.GitWorkingTree)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInterface_ReadGitWorkingTree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadGitWorkingTree'
+type MockInterface_ReadGitWorkingTree_Call struct {
+	*mock.Call
+}
+
+// ReadGitWorkingTree is a helper method to define mock.On call
+func (_e *MockInterface_Expecter) ReadGitWorkingTree() *MockInterface_ReadGitWorkingTree_Call {
+	return &MockInterface_ReadGitWorkingTree_Call{Call: _e.mock.On("ReadGitWorkingTree")}
+}
+
+func (_c *MockInterface_ReadGitWorkingTree_Call) Run(run func()) *MockInterface_ReadGitWorkingTree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockInterface_ReadGitWorkingTree_Call) Return(gitWorkingTree *fs.GitWorkingTree, err error) *MockInterface_ReadGitWorkingTree_Call {
+	_c.Call.Return(gitWorkingTree, err)
+	return _c
+}
+
+func (_c *MockInterface_ReadGitWorkingTree_Call) RunAndReturn(run func() (*fs.GitWorkingTree, error)) *MockInterface_ReadGitWorkingTree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadLFSRules provides a mock function for the type MockInterface
+func (_mock *MockInterface) ReadLFSRules(filename string) (fs.LFSRules, error) {
+	ret := _mock.Called(filename)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadLFSRules")
+	}
+
+	var r0 fs.LFSRules
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (fs.LFSRules, error)); ok {
+		return returnFunc(filename)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) fs.LFSRules); ok {
+		r0 = returnFunc(filename)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(fs.LFSRules)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(filename)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInterface_ReadLFSRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadLFSRules'
+type MockInterface_ReadLFSRules_Call struct {
+	*mock.Call
+}
+
+// ReadLFSRules is a helper method to define mock.On call
+//   - filename string
+func (_e *MockInterface_Expecter) ReadLFSRules(filename any) *MockInterface_ReadLFSRules_Call {
+	return &MockInterface_ReadLFSRules_Call{Call: _e.mock.On("ReadLFSRules", filename)}
+}
+
+func (_c *MockInterface_ReadLFSRules_Call) Run(run func(filename string)) *MockInterface_ReadLFSRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInterface_ReadLFSRules_Call) Return(lFSRules fs.LFSRules, err error) *MockInterface_ReadLFSRules_Call {
+	_c.Call.Return(lFSRules, err)
+	return _c
+}
+
+func (_c *MockInterface_ReadLFSRules_Call) RunAndReturn(run func(filename string) (fs.LFSRules, error)) *MockInterface_ReadLFSRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stdin provides a mock function for the type MockInterface
+func (_mock *MockInterface) Stdin() io.Reader {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stdin")
+	}
+
+	var r0 io.Reader
+	if returnFunc, ok := ret.Get(0).(func() io.Reader); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.Reader)
+		}
+	}
+	return r0
+}
+
+// MockInterface_Stdin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stdin'
+type MockInterface_Stdin_Call struct {
+	*mock.Call
+}
+
+// Stdin is a helper method to define mock.On call
+func (_e *MockInterface_Expecter) Stdin() *MockInterface_Stdin_Call {
+	return &MockInterface_Stdin_Call{Call: _e.mock.On("Stdin")}
+}
+
+func (_c *MockInterface_Stdin_Call) Run(run func()) *MockInterface_Stdin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockInterface_Stdin_Call) Return(reader io.Reader) *MockInterface_Stdin_Call {
+	_c.Call.Return(reader)
 	return _c
 }
 
-func (_c *MockInterface_ReadAsBase64EncodedContent_Call) RunAndReturn(run func(filename string) (string, error)) *MockInterface_ReadAsBase64EncodedContent_Call {
+func (_c *MockInterface_Stdin_Call) RunAndReturn(run func() io.Reader) *MockInterface_Stdin_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -6,6 +6,7 @@ package commitstrategy_mock
 
 import (
 	"github.com/int128/ghcp/pkg/git"
+	"github.com/int128/ghcp/pkg/git/commitstrategy"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -80,6 +81,50 @@ func (_c *MockCommitStrategy_IsFastForward_Call) RunAndReturn(run func() bool) *
 	return _c
 }
 
+// IsMerge provides a mock function for the type MockCommitStrategy
+func (_mock *MockCommitStrategy) IsMerge() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsMerge")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockCommitStrategy_IsMerge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsMerge'
+type MockCommitStrategy_IsMerge_Call struct {
+	*mock.Call
+}
+
+// IsMerge is a helper method to define mock.On call
+func (_e *MockCommitStrategy_Expecter) IsMerge() *MockCommitStrategy_IsMerge_Call {
+	return &MockCommitStrategy_IsMerge_Call{Call: _e.mock.On("IsMerge")}
+}
+
+func (_c *MockCommitStrategy_IsMerge_Call) Run(run func()) *MockCommitStrategy_IsMerge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockCommitStrategy_IsMerge_Call) Return(b bool) *MockCommitStrategy_IsMerge_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockCommitStrategy_IsMerge_Call) RunAndReturn(run func() bool) *MockCommitStrategy_IsMerge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsRebase provides a mock function for the type MockCommitStrategy
 func (_mock *MockCommitStrategy) IsRebase() bool {
 	ret := _mock.Called()
@@ -124,6 +169,138 @@ func (_c *MockCommitStrategy_IsRebase_Call) RunAndReturn(run func() bool) *MockC
 	return _c
 }
 
+// KeepLast provides a mock function for the type MockCommitStrategy
+func (_mock *MockCommitStrategy) KeepLast() int {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for KeepLast")
+	}
+
+	var r0 int
+	if returnFunc, ok := ret.Get(0).(func() int); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	return r0
+}
+
+// MockCommitStrategy_KeepLast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'KeepLast'
+type MockCommitStrategy_KeepLast_Call struct {
+	*mock.Call
+}
+
+// KeepLast is a helper method to define mock.On call
+func (_e *MockCommitStrategy_Expecter) KeepLast() *MockCommitStrategy_KeepLast_Call {
+	return &MockCommitStrategy_KeepLast_Call{Call: _e.mock.On("KeepLast")}
+}
+
+func (_c *MockCommitStrategy_KeepLast_Call) Run(run func()) *MockCommitStrategy_KeepLast_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockCommitStrategy_KeepLast_Call) Return(n int) *MockCommitStrategy_KeepLast_Call {
+	_c.Call.Return(n)
+	return _c
+}
+
+func (_c *MockCommitStrategy_KeepLast_Call) RunAndReturn(run func() int) *MockCommitStrategy_KeepLast_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergeRef provides a mock function for the type MockCommitStrategy
+func (_mock *MockCommitStrategy) MergeRef() git.RefName {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeRef")
+	}
+
+	var r0 git.RefName
+	if returnFunc, ok := ret.Get(0).(func() git.RefName); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(git.RefName)
+	}
+	return r0
+}
+
+// MockCommitStrategy_MergeRef_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeRef'
+type MockCommitStrategy_MergeRef_Call struct {
+	*mock.Call
+}
+
+// MergeRef is a helper method to define mock.On call
+func (_e *MockCommitStrategy_Expecter) MergeRef() *MockCommitStrategy_MergeRef_Call {
+	return &MockCommitStrategy_MergeRef_Call{Call: _e.mock.On("MergeRef")}
+}
+
+func (_c *MockCommitStrategy_MergeRef_Call) Run(run func()) *MockCommitStrategy_MergeRef_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockCommitStrategy_MergeRef_Call) Return(refName git.RefName) *MockCommitStrategy_MergeRef_Call {
+	_c.Call.Return(refName)
+	return _c
+}
+
+func (_c *MockCommitStrategy_MergeRef_Call) RunAndReturn(run func() git.RefName) *MockCommitStrategy_MergeRef_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergeSide provides a mock function for the type MockCommitStrategy
+func (_mock *MockCommitStrategy) MergeSide() commitstrategy.MergeSide {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeSide")
+	}
+
+	var r0 commitstrategy.MergeSide
+	if returnFunc, ok := ret.Get(0).(func() commitstrategy.MergeSide); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(commitstrategy.MergeSide)
+	}
+	return r0
+}
+
+// MockCommitStrategy_MergeSide_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeSide'
+type MockCommitStrategy_MergeSide_Call struct {
+	*mock.Call
+}
+
+// MergeSide is a helper method to define mock.On call
+func (_e *MockCommitStrategy_Expecter) MergeSide() *MockCommitStrategy_MergeSide_Call {
+	return &MockCommitStrategy_MergeSide_Call{Call: _e.mock.On("MergeSide")}
+}
+
+func (_c *MockCommitStrategy_MergeSide_Call) Run(run func()) *MockCommitStrategy_MergeSide_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockCommitStrategy_MergeSide_Call) Return(mergeSide commitstrategy.MergeSide) *MockCommitStrategy_MergeSide_Call {
+	_c.Call.Return(mergeSide)
+	return _c
+}
+
+func (_c *MockCommitStrategy_MergeSide_Call) RunAndReturn(run func() commitstrategy.MergeSide) *MockCommitStrategy_MergeSide_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NoParent provides a mock function for the type MockCommitStrategy
 func (_mock *MockCommitStrategy) NoParent() bool {
 	ret := _mock.Called()
@@ -6,9 +6,11 @@ package client_mock
 
 import (
 	"context"
+	"io"
 	"os"
 
 	"github.com/google/go-github/v88/github"
+	"github.com/int128/ghcp/pkg/github/client"
 	"github.com/shurcooL/githubv4"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -40,57 +42,138 @@ func (_m *MockInterface) EXPECT() *MockInterface_Expecter {
 	return &MockInterface_Expecter{mock: &_m.Mock}
 }
 
-// CreateBlob provides a mock function for the type MockInterface
-func (_mock *MockInterface) CreateBlob(ctx context.Context, owner string, repo string, blob github.Blob) (*github.Blob, *github.Response, error) {
-	ret := _mock.Called(ctx, owner, repo, blob)
+// BatchLFS provides a mock function for the type MockInterface
+func (_mock *MockInterface) BatchLFS(ctx context.Context, owner string, repo string, batch client.LFSBatchRequest) (*client.LFSBatchResponse, error) {
+	ret := _mock.Called(ctx, owner, repo, batch)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateBlob")
+		panic("no return value specified for BatchLFS")
+	}
+
+	var r0 *client.LFSBatchResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, client.LFSBatchRequest) (*client.LFSBatchResponse, error)); ok {
+		return returnFunc(ctx, owner, repo, batch)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, client.LFSBatchRequest) *client.LFSBatchResponse); ok {
+		r0 = returnFunc(ctx, owner, repo, batch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.LFSBatchResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, client.LFSBatchRequest) error); ok {
+		r1 = returnFunc(ctx, owner, repo, batch)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInterface_BatchLFS_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchLFS'
+type MockInterface_BatchLFS_Call struct {
+	*mock.Call
+}
+
+// BatchLFS is a helper method to define mock.On call
+//   - ctx context.Context
+//   - owner string
+//   - repo string
+//   - batch client.LFSBatchRequest
+func (_e *MockInterface_Expecter) BatchLFS(ctx any, owner any, repo any, batch any) *MockInterface_BatchLFS_Call {
+	return &MockInterface_BatchLFS_Call{Call: _e.mock.On("BatchLFS", ctx, owner, repo, batch)}
+}
+
+func (_c *MockInterface_BatchLFS_Call) Run(run func(ctx context.Context, owner string, repo string, batch client.LFSBatchRequest)) *MockInterface_BatchLFS_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 client.LFSBatchRequest
+		if args[3] != nil {
+			arg3 = args[3].(client.LFSBatchRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInterface_BatchLFS_Call) Return(lFSBatchResponse *client.LFSBatchResponse, err error) *MockInterface_BatchLFS_Call {
+	_c.Call.Return(lFSBatchResponse, err)
+	return _c
+}
+
+func (_c *MockInterface_BatchLFS_Call) RunAndReturn(run func(ctx context.Context, owner string, repo string, batch client.LFSBatchRequest) (*client.LFSBatchResponse, error)) *MockInterface_BatchLFS_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateBlobFromReader provides a mock function for the type MockInterface
+func (_mock *MockInterface) CreateBlobFromReader(ctx context.Context, owner string, repo string, content io.Reader, size int64) (*github.Blob, *github.Response, error) {
+	ret := _mock.Called(ctx, owner, repo, content, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBlobFromReader")
 	}
 
 	var r0 *github.Blob
 	var r1 *github.Response
 	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, github.Blob) (*github.Blob, *github.Response, error)); ok {
-		return returnFunc(ctx, owner, repo, blob)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, io.Reader, int64) (*github.Blob, *github.Response, error)); ok {
+		return returnFunc(ctx, owner, repo, content, size)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, github.Blob) *github.Blob); ok {
-		r0 = returnFunc(ctx, owner, repo, blob)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, io.Reader, int64) *github.Blob); ok {
+		r0 = returnFunc(ctx, owner, repo, content, size)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*github.Blob)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, github.Blob) *github.Response); ok {
-		r1 = returnFunc(ctx, owner, repo, blob)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, io.Reader, int64) *github.Response); ok {
+		r1 = returnFunc(ctx, owner, repo, content, size)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*github.Response)
 		}
 	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string, github.Blob) error); ok {
-		r2 = returnFunc(ctx, owner, repo, blob)
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string, io.Reader, int64) error); ok {
+		r2 = returnFunc(ctx, owner, repo, content, size)
 	} else {
 		r2 = ret.Error(2)
 	}
 	return r0, r1, r2
 }
 
-// MockInterface_CreateBlob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBlob'
-type MockInterface_CreateBlob_Call struct {
+// MockInterface_CreateBlobFromReader_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBlobFromReader'
+type MockInterface_CreateBlobFromReader_Call struct {
 	*mock.Call
 }
 
-// CreateBlob is a helper method to define mock.On call
+// CreateBlobFromReader is a helper method to define mock.On call
 //   - ctx context.Context
 //   - owner string
 //   - repo string
-//   - blob github.Blob
-func (_e *MockInterface_Expecter) CreateBlob(ctx any, owner any, repo any, blob any) *MockInterface_CreateBlob_Call {
-	return &MockInterface_CreateBlob_Call{Call: _e.mock.On("CreateBlob", ctx, owner, repo, blob)}
+//   - content io.Reader
+//   - size int64
+func (_e *MockInterface_Expecter) CreateBlobFromReader(ctx any, owner any, repo any, content any, size any) *MockInterface_CreateBlobFromReader_Call {
+	return &MockInterface_CreateBlobFromReader_Call{Call: _e.mock.On("CreateBlobFromReader", ctx, owner, repo, content, size)}
 }
 
-func (_c *MockInterface_CreateBlob_Call) Run(run func(ctx context.Context, owner string, repo string, blob github.Blob)) *MockInterface_CreateBlob_Call {
+func (_c *MockInterface_CreateBlobFromReader_Call) Run(run func(ctx context.Context, owner string, repo string, content io.Reader, size int64)) *MockInterface_CreateBlobFromReader_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -104,26 +187,31 @@ func (_c *MockInterface_CreateBlob_Call) Run(run func(ctx context.Context, owner
 		if args[2] != nil {
 			arg2 = args[2].(string)
 		}
-		var arg3 github.Blob
+		var arg3 io.Reader
 		if args[3] != nil {
-			arg3 = args[3].(github.Blob)
+			arg3 = args[3].(io.Reader)
+		}
+		var arg4 int64
+		if args[4] != nil {
+			arg4 = args[4].(int64)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
 		)
 	})
 	return _c
 }
 
-func (_c *MockInterface_CreateBlob_Call) Return(blob1 *github.Blob, response *github.Response, err error) *MockInterface_CreateBlob_Call {
-	_c.Call.Return(blob1, response, err)
+func (_c *MockInterface_CreateBlobFromReader_Call) Return(blob *github.Blob, response *github.Response, err error) *MockInterface_CreateBlobFromReader_Call {
+	_c.Call.Return(blob, response, err)
 	return _c
 }
 
-func (_c *MockInterface_CreateBlob_Call) RunAndReturn(run func(ctx context.Context, owner string, repo string, blob github.Blob) (*github.Blob, *github.Response, error)) *MockInterface_CreateBlob_Call {
+func (_c *MockInterface_CreateBlobFromReader_Call) RunAndReturn(run func(ctx context.Context, owner string, repo string, content io.Reader, size int64) (*github.Blob, *github.Response, error)) *MockInterface_CreateBlobFromReader_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -492,6 +580,94 @@ func (_c *MockInterface_CreateTree_Call) RunAndReturn(run func(ctx context.Conte
 	return _c
 }
 
+// GetCommit provides a mock function for the type MockInterface
+func (_mock *MockInterface) GetCommit(ctx context.Context, owner string, repo string, sha string) (*github.Commit, *github.Response, error) {
+	ret := _mock.Called(ctx, owner, repo, sha)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCommit")
+	}
+
+	var r0 *github.Commit
+	var r1 *github.Response
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (*github.Commit, *github.Response, error)); ok {
+		return returnFunc(ctx, owner, repo, sha)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) *github.Commit); ok {
+		r0 = returnFunc(ctx, owner, repo, sha)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*github.Commit)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) *github.Response); ok {
+		r1 = returnFunc(ctx, owner, repo, sha)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*github.Response)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string, string) error); ok {
+		r2 = returnFunc(ctx, owner, repo, sha)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockInterface_GetCommit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCommit'
+type MockInterface_GetCommit_Call struct {
+	*mock.Call
+}
+
+// GetCommit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - owner string
+//   - repo string
+//   - sha string
+func (_e *MockInterface_Expecter) GetCommit(ctx any, owner any, repo any, sha any) *MockInterface_GetCommit_Call {
+	return &MockInterface_GetCommit_Call{Call: _e.mock.On("GetCommit", ctx, owner, repo, sha)}
+}
+
+func (_c *MockInterface_GetCommit_Call) Run(run func(ctx context.Context, owner string, repo string, sha string)) *MockInterface_GetCommit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInterface_GetCommit_Call) Return(commit *github.Commit, response *github.Response, err error) *MockInterface_GetCommit_Call {
+	_c.Call.Return(commit, response, err)
+	return _c
+}
+
+func (_c *MockInterface_GetCommit_Call) RunAndReturn(run func(ctx context.Context, owner string, repo string, sha string) (*github.Commit, *github.Response, error)) *MockInterface_GetCommit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetReleaseByTag provides a mock function for the type MockInterface
 func (_mock *MockInterface) GetReleaseByTag(ctx context.Context, owner string, repo string, tag string) (*github.RepositoryRelease, *github.Response, error) {
 	ret := _mock.Called(ctx, owner, repo, tag)
@@ -580,6 +756,100 @@ func (_c *MockInterface_GetReleaseByTag_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// GetTree provides a mock function for the type MockInterface
+func (_mock *MockInterface) GetTree(ctx context.Context, owner string, repo string, sha string, recursive bool) (*github.Tree, *github.Response, error) {
+	ret := _mock.Called(ctx, owner, repo, sha, recursive)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTree")
+	}
+
+	var r0 *github.Tree
+	var r1 *github.Response
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, bool) (*github.Tree, *github.Response, error)); ok {
+		return returnFunc(ctx, owner, repo, sha, recursive)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, bool) *github.Tree); ok {
+		r0 = returnFunc(ctx, owner, repo, sha, recursive)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*github.Tree)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, bool) *github.Response); ok {
+		r1 = returnFunc(ctx, owner, repo, sha, recursive)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*github.Response)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string, string, bool) error); ok {
+		r2 = returnFunc(ctx, owner, repo, sha, recursive)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockInterface_GetTree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTree'
+type MockInterface_GetTree_Call struct {
+	*mock.Call
+}
+
+// GetTree is a helper method to define mock.On call
+//   - ctx context.Context
+//   - owner string
+//   - repo string
+//   - sha string
+//   - recursive bool
+func (_e *MockInterface_Expecter) GetTree(ctx any, owner any, repo any, sha any, recursive any) *MockInterface_GetTree_Call {
+	return &MockInterface_GetTree_Call{Call: _e.mock.On("GetTree", ctx, owner, repo, sha, recursive)}
+}
+
+func (_c *MockInterface_GetTree_Call) Run(run func(ctx context.Context, owner string, repo string, sha string, recursive bool)) *MockInterface_GetTree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 bool
+		if args[4] != nil {
+			arg4 = args[4].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInterface_GetTree_Call) Return(tree *github.Tree, response *github.Response, err error) *MockInterface_GetTree_Call {
+	_c.Call.Return(tree, response, err)
+	return _c
+}
+
+func (_c *MockInterface_GetTree_Call) RunAndReturn(run func(ctx context.Context, owner string, repo string, sha string, recursive bool) (*github.Tree, *github.Response, error)) *MockInterface_GetTree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Mutate provides a mock function for the type MockInterface
 func (_mock *MockInterface) Mutate(ctx context.Context, m interface{}, input githubv4.Input, variables map[string]interface{}) error {
 	ret := _mock.Called(ctx, m, input, variables)
@@ -712,6 +982,75 @@ func (_c *MockInterface_Query_Call) RunAndReturn(run func(ctx context.Context, q
 	return _c
 }
 
+// UploadLFSObject provides a mock function for the type MockInterface
+func (_mock *MockInterface) UploadLFSObject(ctx context.Context, action client.LFSAction, content io.Reader, size int64) error {
+	ret := _mock.Called(ctx, action, content, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadLFSObject")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, client.LFSAction, io.Reader, int64) error); ok {
+		r0 = returnFunc(ctx, action, content, size)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockInterface_UploadLFSObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadLFSObject'
+type MockInterface_UploadLFSObject_Call struct {
+	*mock.Call
+}
+
+// UploadLFSObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - action client.LFSAction
+//   - content io.Reader
+//   - size int64
+func (_e *MockInterface_Expecter) UploadLFSObject(ctx any, action any, content any, size any) *MockInterface_UploadLFSObject_Call {
+	return &MockInterface_UploadLFSObject_Call{Call: _e.mock.On("UploadLFSObject", ctx, action, content, size)}
+}
+
+func (_c *MockInterface_UploadLFSObject_Call) Run(run func(ctx context.Context, action client.LFSAction, content io.Reader, size int64)) *MockInterface_UploadLFSObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 client.LFSAction
+		if args[1] != nil {
+			arg1 = args[1].(client.LFSAction)
+		}
+		var arg2 io.Reader
+		if args[2] != nil {
+			arg2 = args[2].(io.Reader)
+		}
+		var arg3 int64
+		if args[3] != nil {
+			arg3 = args[3].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInterface_UploadLFSObject_Call) Return(err error) *MockInterface_UploadLFSObject_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockInterface_UploadLFSObject_Call) RunAndReturn(run func(ctx context.Context, action client.LFSAction, content io.Reader, size int64) error) *MockInterface_UploadLFSObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UploadReleaseAsset provides a mock function for the type MockInterface
 func (_mock *MockInterface) UploadReleaseAsset(ctx context.Context, owner string, repo string, id int64, opt *github.UploadOptions, file *os.File) (*github.ReleaseAsset, *github.Response, error) {
 	ret := _mock.Called(ctx, owner, repo, id, opt, file)
@@ -812,19 +1151,82 @@ func (_c *MockInterface_UploadReleaseAsset_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
-// NewMockQueryService creates a new instance of MockQueryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockQueryService(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockQueryService {
-	mock := &MockQueryService{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+// VerifyLFSObject provides a mock function for the type MockInterface
+func (_mock *MockInterface) VerifyLFSObject(ctx context.Context, action client.LFSAction, object client.LFSObject) error {
+	ret := _mock.Called(ctx, action, object)
 
-	return mock
-}
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyLFSObject")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, client.LFSAction, client.LFSObject) error); ok {
+		r0 = returnFunc(ctx, action, object)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockInterface_VerifyLFSObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyLFSObject'
+type MockInterface_VerifyLFSObject_Call struct {
+	*mock.Call
+}
+
+// VerifyLFSObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - action client.LFSAction
+//   - object client.LFSObject
+func (_e *MockInterface_Expecter) VerifyLFSObject(ctx any, action any, object any) *MockInterface_VerifyLFSObject_Call {
+	return &MockInterface_VerifyLFSObject_Call{Call: _e.mock.On("VerifyLFSObject", ctx, action, object)}
+}
+
+func (_c *MockInterface_VerifyLFSObject_Call) Run(run func(ctx context.Context, action client.LFSAction, object client.LFSObject)) *MockInterface_VerifyLFSObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 client.LFSAction
+		if args[1] != nil {
+			arg1 = args[1].(client.LFSAction)
+		}
+		var arg2 client.LFSObject
+		if args[2] != nil {
+			arg2 = args[2].(client.LFSObject)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInterface_VerifyLFSObject_Call) Return(err error) *MockInterface_VerifyLFSObject_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockInterface_VerifyLFSObject_Call) RunAndReturn(run func(ctx context.Context, action client.LFSAction, object client.LFSObject) error) *MockInterface_VerifyLFSObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockQueryService creates a new instance of MockQueryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockQueryService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockQueryService {
+	mock := &MockQueryService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
 
 // MockQueryService is an autogenerated mock type for the QueryService type
 type MockQueryService struct {
@@ -998,57 +1400,58 @@ func (_m *MockGitService) EXPECT() *MockGitService_Expecter {
 	return &MockGitService_Expecter{mock: &_m.Mock}
 }
 
-// CreateBlob provides a mock function for the type MockGitService
-func (_mock *MockGitService) CreateBlob(ctx context.Context, owner string, repo string, blob github.Blob) (*github.Blob, *github.Response, error) {
-	ret := _mock.Called(ctx, owner, repo, blob)
+// CreateCommit provides a mock function for the type MockGitService
+func (_mock *MockGitService) CreateCommit(ctx context.Context, owner string, repo string, commit github.Commit, opts *github.CreateCommitOptions) (*github.Commit, *github.Response, error) {
+	ret := _mock.Called(ctx, owner, repo, commit, opts)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateBlob")
+		panic("no return value specified for CreateCommit")
 	}
 
-	var r0 *github.Blob
+	var r0 *github.Commit
 	var r1 *github.Response
 	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, github.Blob) (*github.Blob, *github.Response, error)); ok {
-		return returnFunc(ctx, owner, repo, blob)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, github.Commit, *github.CreateCommitOptions) (*github.Commit, *github.Response, error)); ok {
+		return returnFunc(ctx, owner, repo, commit, opts)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, github.Blob) *github.Blob); ok {
-		r0 = returnFunc(ctx, owner, repo, blob)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, github.Commit, *github.CreateCommitOptions) *github.Commit); ok {
+		r0 = returnFunc(ctx, owner, repo, commit, opts)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*github.Blob)
+			r0 = ret.Get(0).(*github.Commit)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, github.Blob) *github.Response); ok {
-		r1 = returnFunc(ctx, owner, repo, blob)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, github.Commit, *github.CreateCommitOptions) *github.Response); ok {
+		r1 = returnFunc(ctx, owner, repo, commit, opts)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*github.Response)
 		}
 	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string, github.Blob) error); ok {
-		r2 = returnFunc(ctx, owner, repo, blob)
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string, github.Commit, *github.CreateCommitOptions) error); ok {
+		r2 = returnFunc(ctx, owner, repo, commit, opts)
 	} else {
 		r2 = ret.Error(2)
 	}
 	return r0, r1, r2
 }
 
-// MockGitService_CreateBlob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBlob'
-type MockGitService_CreateBlob_Call struct {
+// MockGitService_CreateCommit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCommit'
+type MockGitService_CreateCommit_Call struct {
 	*mock.Call
 }
 
-// CreateBlob is a helper method to define mock.On call
+// CreateCommit is a helper method to define mock.On call
 //   - ctx context.Context
 //   - owner string
 //   - repo string
-//   - blob github.Blob
-func (_e *MockGitService_Expecter) CreateBlob(ctx any, owner any, repo any, blob any) *MockGitService_CreateBlob_Call {
-	return &MockGitService_CreateBlob_Call{Call: _e.mock.On("CreateBlob", ctx, owner, repo, blob)}
+//   - commit github.Commit
+//   - opts *github.CreateCommitOptions
+func (_e *MockGitService_Expecter) CreateCommit(ctx any, owner any, repo any, commit any, opts any) *MockGitService_CreateCommit_Call {
+	return &MockGitService_CreateCommit_Call{Call: _e.mock.On("CreateCommit", ctx, owner, repo, commit, opts)}
 }
 
-func (_c *MockGitService_CreateBlob_Call) Run(run func(ctx context.Context, owner string, repo string, blob github.Blob)) *MockGitService_CreateBlob_Call {
+func (_c *MockGitService_CreateCommit_Call) Run(run func(ctx context.Context, owner string, repo string, commit github.Commit, opts *github.CreateCommitOptions)) *MockGitService_CreateCommit_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1062,82 +1465,87 @@ func (_c *MockGitService_CreateBlob_Call) Run(run func(ctx context.Context, owne
 		if args[2] != nil {
 			arg2 = args[2].(string)
 		}
-		var arg3 github.Blob
+		var arg3 github.Commit
 		if args[3] != nil {
-			arg3 = args[3].(github.Blob)
+			arg3 = args[3].(github.Commit)
+		}
+		var arg4 *github.CreateCommitOptions
+		if args[4] != nil {
+			arg4 = args[4].(*github.CreateCommitOptions)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
 		)
 	})
 	return _c
 }
 
-func (_c *MockGitService_CreateBlob_Call) Return(blob1 *github.Blob, response *github.Response, err error) *MockGitService_CreateBlob_Call {
-	_c.Call.Return(blob1, response, err)
+func (_c *MockGitService_CreateCommit_Call) Return(commit1 *github.Commit, response *github.Response, err error) *MockGitService_CreateCommit_Call {
+	_c.Call.Return(commit1, response, err)
 	return _c
 }
 
-func (_c *MockGitService_CreateBlob_Call) RunAndReturn(run func(ctx context.Context, owner string, repo string, blob github.Blob) (*github.Blob, *github.Response, error)) *MockGitService_CreateBlob_Call {
+func (_c *MockGitService_CreateCommit_Call) RunAndReturn(run func(ctx context.Context, owner string, repo string, commit github.Commit, opts *github.CreateCommitOptions) (*github.Commit, *github.Response, error)) *MockGitService_CreateCommit_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateCommit provides a mock function for the type MockGitService
-func (_mock *MockGitService) CreateCommit(ctx context.Context, owner string, repo string, commit github.Commit, opts *github.CreateCommitOptions) (*github.Commit, *github.Response, error) {
-	ret := _mock.Called(ctx, owner, repo, commit, opts)
+// CreateTree provides a mock function for the type MockGitService
+func (_mock *MockGitService) CreateTree(ctx context.Context, owner string, repo string, baseTree string, entries []*github.TreeEntry) (*github.Tree, *github.Response, error) {
+	ret := _mock.Called(ctx, owner, repo, baseTree, entries)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateCommit")
+		panic("no return value specified for CreateTree")
 	}
 
-	var r0 *github.Commit
+	var r0 *github.Tree
 	var r1 *github.Response
 	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, github.Commit, *github.CreateCommitOptions) (*github.Commit, *github.Response, error)); ok {
-		return returnFunc(ctx, owner, repo, commit, opts)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, []*github.TreeEntry) (*github.Tree, *github.Response, error)); ok {
+		return returnFunc(ctx, owner, repo, baseTree, entries)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, github.Commit, *github.CreateCommitOptions) *github.Commit); ok {
-		r0 = returnFunc(ctx, owner, repo, commit, opts)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, []*github.TreeEntry) *github.Tree); ok {
+		r0 = returnFunc(ctx, owner, repo, baseTree, entries)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*github.Commit)
+			r0 = ret.Get(0).(*github.Tree)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, github.Commit, *github.CreateCommitOptions) *github.Response); ok {
-		r1 = returnFunc(ctx, owner, repo, commit, opts)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, []*github.TreeEntry) *github.Response); ok {
+		r1 = returnFunc(ctx, owner, repo, baseTree, entries)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*github.Response)
 		}
 	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string, github.Commit, *github.CreateCommitOptions) error); ok {
-		r2 = returnFunc(ctx, owner, repo, commit, opts)
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string, string, []*github.TreeEntry) error); ok {
+		r2 = returnFunc(ctx, owner, repo, baseTree, entries)
 	} else {
 		r2 = ret.Error(2)
 	}
 	return r0, r1, r2
 }
 
-// MockGitService_CreateCommit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCommit'
-type MockGitService_CreateCommit_Call struct {
+// MockGitService_CreateTree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTree'
+type MockGitService_CreateTree_Call struct {
 	*mock.Call
 }
 
-// CreateCommit is a helper method to define mock.On call
+// CreateTree is a helper method to define mock.On call
 //   - ctx context.Context
 //   - owner string
 //   - repo string
-//   - commit github.Commit
-//   - opts *github.CreateCommitOptions
-func (_e *MockGitService_Expecter) CreateCommit(ctx any, owner any, repo any, commit any, opts any) *MockGitService_CreateCommit_Call {
-	return &MockGitService_CreateCommit_Call{Call: _e.mock.On("CreateCommit", ctx, owner, repo, commit, opts)}
+//   - baseTree string
+//   - entries []*github.TreeEntry
+func (_e *MockGitService_Expecter) CreateTree(ctx any, owner any, repo any, baseTree any, entries any) *MockGitService_CreateTree_Call {
+	return &MockGitService_CreateTree_Call{Call: _e.mock.On("CreateTree", ctx, owner, repo, baseTree, entries)}
 }
 
-func (_c *MockGitService_CreateCommit_Call) Run(run func(ctx context.Context, owner string, repo string, commit github.Commit, opts *github.CreateCommitOptions)) *MockGitService_CreateCommit_Call {
+func (_c *MockGitService_CreateTree_Call) Run(run func(ctx context.Context, owner string, repo string, baseTree string, entries []*github.TreeEntry)) *MockGitService_CreateTree_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1151,13 +1559,13 @@ func (_c *MockGitService_CreateCommit_Call) Run(run func(ctx context.Context, ow
 		if args[2] != nil {
 			arg2 = args[2].(string)
 		}
-		var arg3 github.Commit
+		var arg3 string
 		if args[3] != nil {
-			arg3 = args[3].(github.Commit)
+			arg3 = args[3].(string)
 		}
-		var arg4 *github.CreateCommitOptions
+		var arg4 []*github.TreeEntry
 		if args[4] != nil {
-			arg4 = args[4].(*github.CreateCommitOptions)
+			arg4 = args[4].([]*github.TreeEntry)
 		}
 		run(
 			arg0,
@@ -1170,68 +1578,156 @@ func (_c *MockGitService_CreateCommit_Call) Run(run func(ctx context.Context, ow
 	return _c
 }
 
-func (_c *MockGitService_CreateCommit_Call) Return(commit1 *github.Commit, response *github.Response, err error) *MockGitService_CreateCommit_Call {
-	_c.Call.Return(commit1, response, err)
+func (_c *MockGitService_CreateTree_Call) Return(tree *github.Tree, response *github.Response, err error) *MockGitService_CreateTree_Call {
+	_c.Call.Return(tree, response, err)
 	return _c
 }
 
-func (_c *MockGitService_CreateCommit_Call) RunAndReturn(run func(ctx context.Context, owner string, repo string, commit github.Commit, opts *github.CreateCommitOptions) (*github.Commit, *github.Response, error)) *MockGitService_CreateCommit_Call {
+func (_c *MockGitService_CreateTree_Call) RunAndReturn(run func(ctx context.Context, owner string, repo string, baseTree string, entries []*github.TreeEntry) (*github.Tree, *github.Response, error)) *MockGitService_CreateTree_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateTree provides a mock function for the type MockGitService
-func (_mock *MockGitService) CreateTree(ctx context.Context, owner string, repo string, baseTree string, entries []*github.TreeEntry) (*github.Tree, *github.Response, error) {
-	ret := _mock.Called(ctx, owner, repo, baseTree, entries)
+// GetCommit provides a mock function for the type MockGitService
+func (_mock *MockGitService) GetCommit(ctx context.Context, owner string, repo string, sha string) (*github.Commit, *github.Response, error) {
+	ret := _mock.Called(ctx, owner, repo, sha)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateTree")
+		panic("no return value specified for GetCommit")
+	}
+
+	var r0 *github.Commit
+	var r1 *github.Response
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (*github.Commit, *github.Response, error)); ok {
+		return returnFunc(ctx, owner, repo, sha)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) *github.Commit); ok {
+		r0 = returnFunc(ctx, owner, repo, sha)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*github.Commit)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) *github.Response); ok {
+		r1 = returnFunc(ctx, owner, repo, sha)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*github.Response)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string, string) error); ok {
+		r2 = returnFunc(ctx, owner, repo, sha)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockGitService_GetCommit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCommit'
+type MockGitService_GetCommit_Call struct {
+	*mock.Call
+}
+
+// GetCommit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - owner string
+//   - repo string
+//   - sha string
+func (_e *MockGitService_Expecter) GetCommit(ctx any, owner any, repo any, sha any) *MockGitService_GetCommit_Call {
+	return &MockGitService_GetCommit_Call{Call: _e.mock.On("GetCommit", ctx, owner, repo, sha)}
+}
+
+func (_c *MockGitService_GetCommit_Call) Run(run func(ctx context.Context, owner string, repo string, sha string)) *MockGitService_GetCommit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGitService_GetCommit_Call) Return(commit *github.Commit, response *github.Response, err error) *MockGitService_GetCommit_Call {
+	_c.Call.Return(commit, response, err)
+	return _c
+}
+
+func (_c *MockGitService_GetCommit_Call) RunAndReturn(run func(ctx context.Context, owner string, repo string, sha string) (*github.Commit, *github.Response, error)) *MockGitService_GetCommit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTree provides a mock function for the type MockGitService
+func (_mock *MockGitService) GetTree(ctx context.Context, owner string, repo string, sha string, recursive bool) (*github.Tree, *github.Response, error) {
+	ret := _mock.Called(ctx, owner, repo, sha, recursive)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTree")
 	}
 
 	var r0 *github.Tree
 	var r1 *github.Response
 	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, []*github.TreeEntry) (*github.Tree, *github.Response, error)); ok {
-		return returnFunc(ctx, owner, repo, baseTree, entries)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, bool) (*github.Tree, *github.Response, error)); ok {
+		return returnFunc(ctx, owner, repo, sha, recursive)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, []*github.TreeEntry) *github.Tree); ok {
-		r0 = returnFunc(ctx, owner, repo, baseTree, entries)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, bool) *github.Tree); ok {
+		r0 = returnFunc(ctx, owner, repo, sha, recursive)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*github.Tree)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, []*github.TreeEntry) *github.Response); ok {
-		r1 = returnFunc(ctx, owner, repo, baseTree, entries)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, bool) *github.Response); ok {
+		r1 = returnFunc(ctx, owner, repo, sha, recursive)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*github.Response)
 		}
 	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string, string, []*github.TreeEntry) error); ok {
-		r2 = returnFunc(ctx, owner, repo, baseTree, entries)
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string, string, bool) error); ok {
+		r2 = returnFunc(ctx, owner, repo, sha, recursive)
 	} else {
 		r2 = ret.Error(2)
 	}
 	return r0, r1, r2
 }
 
-// MockGitService_CreateTree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTree'
-type MockGitService_CreateTree_Call struct {
+// MockGitService_GetTree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTree'
+type MockGitService_GetTree_Call struct {
 	*mock.Call
 }
 
-// CreateTree is a helper method to define mock.On call
+// GetTree is a helper method to define mock.On call
 //   - ctx context.Context
 //   - owner string
 //   - repo string
-//   - baseTree string
-//   - entries []*github.TreeEntry
-func (_e *MockGitService_Expecter) CreateTree(ctx any, owner any, repo any, baseTree any, entries any) *MockGitService_CreateTree_Call {
-	return &MockGitService_CreateTree_Call{Call: _e.mock.On("CreateTree", ctx, owner, repo, baseTree, entries)}
+//   - sha string
+//   - recursive bool
+func (_e *MockGitService_Expecter) GetTree(ctx any, owner any, repo any, sha any, recursive any) *MockGitService_GetTree_Call {
+	return &MockGitService_GetTree_Call{Call: _e.mock.On("GetTree", ctx, owner, repo, sha, recursive)}
 }
 
-func (_c *MockGitService_CreateTree_Call) Run(run func(ctx context.Context, owner string, repo string, baseTree string, entries []*github.TreeEntry)) *MockGitService_CreateTree_Call {
+func (_c *MockGitService_GetTree_Call) Run(run func(ctx context.Context, owner string, repo string, sha string, recursive bool)) *MockGitService_GetTree_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1249,9 +1745,9 @@ func (_c *MockGitService_CreateTree_Call) Run(run func(ctx context.Context, owne
 		if args[3] != nil {
 			arg3 = args[3].(string)
 		}
-		var arg4 []*github.TreeEntry
+		var arg4 bool
 		if args[4] != nil {
-			arg4 = args[4].([]*github.TreeEntry)
+			arg4 = args[4].(bool)
 		}
 		run(
 			arg0,
@@ -1264,12 +1760,372 @@ func (_c *MockGitService_CreateTree_Call) Run(run func(ctx context.Context, owne
 	return _c
 }
 
-func (_c *MockGitService_CreateTree_Call) Return(tree *github.Tree, response *github.Response, err error) *MockGitService_CreateTree_Call {
+func (_c *MockGitService_GetTree_Call) Return(tree *github.Tree, response *github.Response, err error) *MockGitService_GetTree_Call {
 	_c.Call.Return(tree, response, err)
 	return _c
 }
 
-func (_c *MockGitService_CreateTree_Call) RunAndReturn(run func(ctx context.Context, owner string, repo string, baseTree string, entries []*github.TreeEntry) (*github.Tree, *github.Response, error)) *MockGitService_CreateTree_Call {
+func (_c *MockGitService_GetTree_Call) RunAndReturn(run func(ctx context.Context, owner string, repo string, sha string, recursive bool) (*github.Tree, *github.Response, error)) *MockGitService_GetTree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockBlobService creates a new instance of MockBlobService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBlobService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBlobService {
+	mock := &MockBlobService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockBlobService is an autogenerated mock type for the BlobService type
+type MockBlobService struct {
+	mock.Mock
+}
+
+type MockBlobService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBlobService) EXPECT() *MockBlobService_Expecter {
+	return &MockBlobService_Expecter{mock: &_m.Mock}
+}
+
+// CreateBlobFromReader provides a mock function for the type MockBlobService
+func (_mock *MockBlobService) CreateBlobFromReader(ctx context.Context, owner string, repo string, content io.Reader, size int64) (*github.Blob, *github.Response, error) {
+	ret := _mock.Called(ctx, owner, repo, content, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBlobFromReader")
+	}
+
+	var r0 *github.Blob
+	var r1 *github.Response
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, io.Reader, int64) (*github.Blob, *github.Response, error)); ok {
+		return returnFunc(ctx, owner, repo, content, size)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, io.Reader, int64) *github.Blob); ok {
+		r0 = returnFunc(ctx, owner, repo, content, size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*github.Blob)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, io.Reader, int64) *github.Response); ok {
+		r1 = returnFunc(ctx, owner, repo, content, size)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*github.Response)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string, io.Reader, int64) error); ok {
+		r2 = returnFunc(ctx, owner, repo, content, size)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockBlobService_CreateBlobFromReader_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBlobFromReader'
+type MockBlobService_CreateBlobFromReader_Call struct {
+	*mock.Call
+}
+
+// CreateBlobFromReader is a helper method to define mock.On call
+//   - ctx context.Context
+//   - owner string
+//   - repo string
+//   - content io.Reader
+//   - size int64
+func (_e *MockBlobService_Expecter) CreateBlobFromReader(ctx any, owner any, repo any, content any, size any) *MockBlobService_CreateBlobFromReader_Call {
+	return &MockBlobService_CreateBlobFromReader_Call{Call: _e.mock.On("CreateBlobFromReader", ctx, owner, repo, content, size)}
+}
+
+func (_c *MockBlobService_CreateBlobFromReader_Call) Run(run func(ctx context.Context, owner string, repo string, content io.Reader, size int64)) *MockBlobService_CreateBlobFromReader_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 io.Reader
+		if args[3] != nil {
+			arg3 = args[3].(io.Reader)
+		}
+		var arg4 int64
+		if args[4] != nil {
+			arg4 = args[4].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBlobService_CreateBlobFromReader_Call) Return(blob *github.Blob, response *github.Response, err error) *MockBlobService_CreateBlobFromReader_Call {
+	_c.Call.Return(blob, response, err)
+	return _c
+}
+
+func (_c *MockBlobService_CreateBlobFromReader_Call) RunAndReturn(run func(ctx context.Context, owner string, repo string, content io.Reader, size int64) (*github.Blob, *github.Response, error)) *MockBlobService_CreateBlobFromReader_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLFSService creates a new instance of MockLFSService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLFSService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLFSService {
+	mock := &MockLFSService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockLFSService is an autogenerated mock type for the LFSService type
+type MockLFSService struct {
+	mock.Mock
+}
+
+type MockLFSService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLFSService) EXPECT() *MockLFSService_Expecter {
+	return &MockLFSService_Expecter{mock: &_m.Mock}
+}
+
+// BatchLFS provides a mock function for the type MockLFSService
+func (_mock *MockLFSService) BatchLFS(ctx context.Context, owner string, repo string, batch client.LFSBatchRequest) (*client.LFSBatchResponse, error) {
+	ret := _mock.Called(ctx, owner, repo, batch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchLFS")
+	}
+
+	var r0 *client.LFSBatchResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, client.LFSBatchRequest) (*client.LFSBatchResponse, error)); ok {
+		return returnFunc(ctx, owner, repo, batch)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, client.LFSBatchRequest) *client.LFSBatchResponse); ok {
+		r0 = returnFunc(ctx, owner, repo, batch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.LFSBatchResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, client.LFSBatchRequest) error); ok {
+		r1 = returnFunc(ctx, owner, repo, batch)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLFSService_BatchLFS_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchLFS'
+type MockLFSService_BatchLFS_Call struct {
+	*mock.Call
+}
+
+// BatchLFS is a helper method to define mock.On call
+//   - ctx context.Context
+//   - owner string
+//   - repo string
+//   - batch client.LFSBatchRequest
+func (_e *MockLFSService_Expecter) BatchLFS(ctx any, owner any, repo any, batch any) *MockLFSService_BatchLFS_Call {
+	return &MockLFSService_BatchLFS_Call{Call: _e.mock.On("BatchLFS", ctx, owner, repo, batch)}
+}
+
+func (_c *MockLFSService_BatchLFS_Call) Run(run func(ctx context.Context, owner string, repo string, batch client.LFSBatchRequest)) *MockLFSService_BatchLFS_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 client.LFSBatchRequest
+		if args[3] != nil {
+			arg3 = args[3].(client.LFSBatchRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLFSService_BatchLFS_Call) Return(lFSBatchResponse *client.LFSBatchResponse, err error) *MockLFSService_BatchLFS_Call {
+	_c.Call.Return(lFSBatchResponse, err)
+	return _c
+}
+
+func (_c *MockLFSService_BatchLFS_Call) RunAndReturn(run func(ctx context.Context, owner string, repo string, batch client.LFSBatchRequest) (*client.LFSBatchResponse, error)) *MockLFSService_BatchLFS_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UploadLFSObject provides a mock function for the type MockLFSService
+func (_mock *MockLFSService) UploadLFSObject(ctx context.Context, action client.LFSAction, content io.Reader, size int64) error {
+	ret := _mock.Called(ctx, action, content, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadLFSObject")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, client.LFSAction, io.Reader, int64) error); ok {
+		r0 = returnFunc(ctx, action, content, size)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLFSService_UploadLFSObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadLFSObject'
+type MockLFSService_UploadLFSObject_Call struct {
+	*mock.Call
+}
+
+// UploadLFSObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - action client.LFSAction
+//   - content io.Reader
+//   - size int64
+func (_e *MockLFSService_Expecter) UploadLFSObject(ctx any, action any, content any, size any) *MockLFSService_UploadLFSObject_Call {
+	return &MockLFSService_UploadLFSObject_Call{Call: _e.mock.On("UploadLFSObject", ctx, action, content, size)}
+}
+
+func (_c *MockLFSService_UploadLFSObject_Call) Run(run func(ctx context.Context, action client.LFSAction, content io.Reader, size int64)) *MockLFSService_UploadLFSObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 client.LFSAction
+		if args[1] != nil {
+			arg1 = args[1].(client.LFSAction)
+		}
+		var arg2 io.Reader
+		if args[2] != nil {
+			arg2 = args[2].(io.Reader)
+		}
+		var arg3 int64
+		if args[3] != nil {
+			arg3 = args[3].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLFSService_UploadLFSObject_Call) Return(err error) *MockLFSService_UploadLFSObject_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLFSService_UploadLFSObject_Call) RunAndReturn(run func(ctx context.Context, action client.LFSAction, content io.Reader, size int64) error) *MockLFSService_UploadLFSObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyLFSObject provides a mock function for the type MockLFSService
+func (_mock *MockLFSService) VerifyLFSObject(ctx context.Context, action client.LFSAction, object client.LFSObject) error {
+	ret := _mock.Called(ctx, action, object)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyLFSObject")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, client.LFSAction, client.LFSObject) error); ok {
+		r0 = returnFunc(ctx, action, object)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLFSService_VerifyLFSObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyLFSObject'
+type MockLFSService_VerifyLFSObject_Call struct {
+	*mock.Call
+}
+
+// VerifyLFSObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - action client.LFSAction
+//   - object client.LFSObject
+func (_e *MockLFSService_Expecter) VerifyLFSObject(ctx any, action any, object any) *MockLFSService_VerifyLFSObject_Call {
+	return &MockLFSService_VerifyLFSObject_Call{Call: _e.mock.On("VerifyLFSObject", ctx, action, object)}
+}
+
+func (_c *MockLFSService_VerifyLFSObject_Call) Run(run func(ctx context.Context, action client.LFSAction, object client.LFSObject)) *MockLFSService_VerifyLFSObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 client.LFSAction
+		if args[1] != nil {
+			arg1 = args[1].(client.LFSAction)
+		}
+		var arg2 client.LFSObject
+		if args[2] != nil {
+			arg2 = args[2].(client.LFSObject)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLFSService_VerifyLFSObject_Call) Return(err error) *MockLFSService_VerifyLFSObject_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLFSService_VerifyLFSObject_Call) RunAndReturn(run func(ctx context.Context, action client.LFSAction, object client.LFSObject) error) *MockLFSService_VerifyLFSObject_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -228,6 +228,72 @@ func (_c *MockInterface_CreateCommit_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
+// CreateCommitOnBranch provides a mock function for the type MockInterface
+func (_mock *MockInterface) CreateCommitOnBranch(ctx context.Context, in github.CreateCommitOnBranchInput) (git.CommitSHA, error) {
+	ret := _mock.Called(ctx, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCommitOnBranch")
+	}
+
+	var r0 git.CommitSHA
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, github.CreateCommitOnBranchInput) (git.CommitSHA, error)); ok {
+		return returnFunc(ctx, in)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, github.CreateCommitOnBranchInput) git.CommitSHA); ok {
+		r0 = returnFunc(ctx, in)
+	} else {
+		r0 = ret.Get(0).(git.CommitSHA)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, github.CreateCommitOnBranchInput) error); ok {
+		r1 = returnFunc(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInterface_CreateCommitOnBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCommitOnBranch'
+type MockInterface_CreateCommitOnBranch_Call struct {
+	*mock.Call
+}
+
+// CreateCommitOnBranch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in github.CreateCommitOnBranchInput
+func (_e *MockInterface_Expecter) CreateCommitOnBranch(ctx any, in any) *MockInterface_CreateCommitOnBranch_Call {
+	return &MockInterface_CreateCommitOnBranch_Call{Call: _e.mock.On("CreateCommitOnBranch", ctx, in)}
+}
+
+func (_c *MockInterface_CreateCommitOnBranch_Call) Run(run func(ctx context.Context, in github.CreateCommitOnBranchInput)) *MockInterface_CreateCommitOnBranch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 github.CreateCommitOnBranchInput
+		if args[1] != nil {
+			arg1 = args[1].(github.CreateCommitOnBranchInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInterface_CreateCommitOnBranch_Call) Return(commitSHA git.CommitSHA, err error) *MockInterface_CreateCommitOnBranch_Call {
+	_c.Call.Return(commitSHA, err)
+	return _c
+}
+
+func (_c *MockInterface_CreateCommitOnBranch_Call) RunAndReturn(run func(ctx context.Context, in github.CreateCommitOnBranchInput) (git.CommitSHA, error)) *MockInterface_CreateCommitOnBranch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateFork provides a mock function for the type MockInterface
 func (_mock *MockInterface) CreateFork(ctx context.Context, id git.RepositoryID) (*git.RepositoryID, error) {
 	ret := _mock.Called(ctx, id)
@@ -555,6 +621,137 @@ func (_c *MockInterface_CreateTree_Call) RunAndReturn(run func(ctx context.Conte
 	return _c
 }
 
+// DeleteBranch provides a mock function for the type MockInterface
+func (_mock *MockInterface) DeleteBranch(ctx context.Context, in github.DeleteBranchInput) error {
+	ret := _mock.Called(ctx, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBranch")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, github.DeleteBranchInput) error); ok {
+		r0 = returnFunc(ctx, in)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockInterface_DeleteBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBranch'
+type MockInterface_DeleteBranch_Call struct {
+	*mock.Call
+}
+
+// DeleteBranch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in github.DeleteBranchInput
+func (_e *MockInterface_Expecter) DeleteBranch(ctx any, in any) *MockInterface_DeleteBranch_Call {
+	return &MockInterface_DeleteBranch_Call{Call: _e.mock.On("DeleteBranch", ctx, in)}
+}
+
+func (_c *MockInterface_DeleteBranch_Call) Run(run func(ctx context.Context, in github.DeleteBranchInput)) *MockInterface_DeleteBranch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 github.DeleteBranchInput
+		if args[1] != nil {
+			arg1 = args[1].(github.DeleteBranchInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInterface_DeleteBranch_Call) Return(err error) *MockInterface_DeleteBranch_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockInterface_DeleteBranch_Call) RunAndReturn(run func(ctx context.Context, in github.DeleteBranchInput) error) *MockInterface_DeleteBranch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCommit provides a mock function for the type MockInterface
+func (_mock *MockInterface) GetCommit(ctx context.Context, repo git.RepositoryID, sha git.CommitSHA) (*git.Commit, error) {
+	ret := _mock.Called(ctx, repo, sha)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCommit")
+	}
+
+	var r0 *git.Commit
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, git.RepositoryID, git.CommitSHA) (*git.Commit, error)); ok {
+		return returnFunc(ctx, repo, sha)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, git.RepositoryID, git.CommitSHA) *git.Commit); ok {
+		r0 = returnFunc(ctx, repo, sha)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*git.Commit)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, git.RepositoryID, git.CommitSHA) error); ok {
+		r1 = returnFunc(ctx, repo, sha)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInterface_GetCommit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCommit'
+type MockInterface_GetCommit_Call struct {
+	*mock.Call
+}
+
+// GetCommit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - repo git.RepositoryID
+//   - sha git.CommitSHA
+func (_e *MockInterface_Expecter) GetCommit(ctx any, repo any, sha any) *MockInterface_GetCommit_Call {
+	return &MockInterface_GetCommit_Call{Call: _e.mock.On("GetCommit", ctx, repo, sha)}
+}
+
+func (_c *MockInterface_GetCommit_Call) Run(run func(ctx context.Context, repo git.RepositoryID, sha git.CommitSHA)) *MockInterface_GetCommit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 git.RepositoryID
+		if args[1] != nil {
+			arg1 = args[1].(git.RepositoryID)
+		}
+		var arg2 git.CommitSHA
+		if args[2] != nil {
+			arg2 = args[2].(git.CommitSHA)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInterface_GetCommit_Call) Return(commit *git.Commit, err error) *MockInterface_GetCommit_Call {
+	_c.Call.Return(commit, err)
+	return _c
+}
+
+func (_c *MockInterface_GetCommit_Call) RunAndReturn(run func(ctx context.Context, repo git.RepositoryID, sha git.CommitSHA) (*git.Commit, error)) *MockInterface_GetCommit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetReleaseByTagOrNil provides a mock function for the type MockInterface
 func (_mock *MockInterface) GetReleaseByTagOrNil(ctx context.Context, repo git.RepositoryID, tag git.TagName) (*git.Release, error) {
 	ret := _mock.Called(ctx, repo, tag)
@@ -629,6 +826,80 @@ func (_c *MockInterface_GetReleaseByTagOrNil_Call) RunAndReturn(run func(ctx con
 	return _c
 }
 
+// GetTree provides a mock function for the type MockInterface
+func (_mock *MockInterface) GetTree(ctx context.Context, repo git.RepositoryID, sha git.TreeSHA) (*git.Tree, error) {
+	ret := _mock.Called(ctx, repo, sha)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTree")
+	}
+
+	var r0 *git.Tree
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, git.RepositoryID, git.TreeSHA) (*git.Tree, error)); ok {
+		return returnFunc(ctx, repo, sha)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, git.RepositoryID, git.TreeSHA) *git.Tree); ok {
+		r0 = returnFunc(ctx, repo, sha)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*git.Tree)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, git.RepositoryID, git.TreeSHA) error); ok {
+		r1 = returnFunc(ctx, repo, sha)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInterface_GetTree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTree'
+type MockInterface_GetTree_Call struct {
+	*mock.Call
+}
+
+// GetTree is a helper method to define mock.On call
+//   - ctx context.Context
+//   - repo git.RepositoryID
+//   - sha git.TreeSHA
+func (_e *MockInterface_Expecter) GetTree(ctx any, repo any, sha any) *MockInterface_GetTree_Call {
+	return &MockInterface_GetTree_Call{Call: _e.mock.On("GetTree", ctx, repo, sha)}
+}
+
+func (_c *MockInterface_GetTree_Call) Run(run func(ctx context.Context, repo git.RepositoryID, sha git.TreeSHA)) *MockInterface_GetTree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 git.RepositoryID
+		if args[1] != nil {
+			arg1 = args[1].(git.RepositoryID)
+		}
+		var arg2 git.TreeSHA
+		if args[2] != nil {
+			arg2 = args[2].(git.TreeSHA)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInterface_GetTree_Call) Return(tree *git.Tree, err error) *MockInterface_GetTree_Call {
+	_c.Call.Return(tree, err)
+	return _c
+}
+
+func (_c *MockInterface_GetTree_Call) RunAndReturn(run func(ctx context.Context, repo git.RepositoryID, sha git.TreeSHA) (*git.Tree, error)) *MockInterface_GetTree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // QueryCommit provides a mock function for the type MockInterface
 func (_mock *MockInterface) QueryCommit(ctx context.Context, in github.QueryCommitInput) (*github.QueryCommitOutput, error) {
 	ret := _mock.Called(ctx, in)
@@ -1015,6 +1286,63 @@ func (_c *MockInterface_UpdateBranch_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
+// UploadLFSObject provides a mock function for the type MockInterface
+func (_mock *MockInterface) UploadLFSObject(ctx context.Context, in github.UploadLFSObjectInput) error {
+	ret := _mock.Called(ctx, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadLFSObject")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, github.UploadLFSObjectInput) error); ok {
+		r0 = returnFunc(ctx, in)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockInterface_UploadLFSObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadLFSObject'
+type MockInterface_UploadLFSObject_Call struct {
+	*mock.Call
+}
+
+// UploadLFSObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in github.UploadLFSObjectInput
+func (_e *MockInterface_Expecter) UploadLFSObject(ctx any, in any) *MockInterface_UploadLFSObject_Call {
+	return &MockInterface_UploadLFSObject_Call{Call: _e.mock.On("UploadLFSObject", ctx, in)}
+}
+
+func (_c *MockInterface_UploadLFSObject_Call) Run(run func(ctx context.Context, in github.UploadLFSObjectInput)) *MockInterface_UploadLFSObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 github.UploadLFSObjectInput
+		if args[1] != nil {
+			arg1 = args[1].(github.UploadLFSObjectInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInterface_UploadLFSObject_Call) Return(err error) *MockInterface_UploadLFSObject_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockInterface_UploadLFSObject_Call) RunAndReturn(run func(ctx context.Context, in github.UploadLFSObjectInput) error) *MockInterface_UploadLFSObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockInternalRepositoryNodeID creates a new instance of MockInternalRepositoryNodeID. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockInternalRepositoryNodeID(t interface {
@@ -0,0 +1,111 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package signer_mock
+
+import (
+	"context"
+
+	"github.com/int128/ghcp/pkg/git"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockInterface creates a new instance of MockInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockInterface {
+	mock := &MockInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockInterface is an autogenerated mock type for the Interface type
+type MockInterface struct {
+	mock.Mock
+}
+
+type MockInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockInterface) EXPECT() *MockInterface_Expecter {
+	return &MockInterface_Expecter{mock: &_m.Mock}
+}
+
+// Sign provides a mock function for the type MockInterface
+func (_mock *MockInterface) Sign(ctx context.Context, key git.SigningKey, payload []byte) (string, error) {
+	ret := _mock.Called(ctx, key, payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Sign")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, git.SigningKey, []byte) (string, error)); ok {
+		return returnFunc(ctx, key, payload)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, git.SigningKey, []byte) string); ok {
+		r0 = returnFunc(ctx, key, payload)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, git.SigningKey, []byte) error); ok {
+		r1 = returnFunc(ctx, key, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInterface_Sign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sign'
+type MockInterface_Sign_Call struct {
+	*mock.Call
+}
+
+// Sign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key git.SigningKey
+//   - payload []byte
+func (_e *MockInterface_Expecter) Sign(ctx any, key any, payload any) *MockInterface_Sign_Call {
+	return &MockInterface_Sign_Call{Call: _e.mock.On("Sign", ctx, key, payload)}
+}
+
+func (_c *MockInterface_Sign_Call) Run(run func(ctx context.Context, key git.SigningKey, payload []byte)) *MockInterface_Sign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 git.SigningKey
+		if args[1] != nil {
+			arg1 = args[1].(git.SigningKey)
+		}
+		var arg2 []byte
+		if args[2] != nil {
+			arg2 = args[2].([]byte)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInterface_Sign_Call) Return(s string, err error) *MockInterface_Sign_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockInterface_Sign_Call) RunAndReturn(run func(ctx context.Context, key git.SigningKey, payload []byte) (string, error)) *MockInterface_Sign_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -39,20 +39,31 @@ func (_m *MockInterface) EXPECT() *MockInterface_Expecter {
 }
 
 // Do provides a mock function for the type MockInterface
-func (_mock *MockInterface) Do(ctx context.Context, in commit.Input) error {
+func (_mock *MockInterface) Do(ctx context.Context, in commit.Input) (*commit.Output, error) {
 	ret := _mock.Called(ctx, in)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Do")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, commit.Input) error); ok {
+	var r0 *commit.Output
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, commit.Input) (*commit.Output, error)); ok {
+		return returnFunc(ctx, in)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, commit.Input) *commit.Output); ok {
 		r0 = returnFunc(ctx, in)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commit.Output)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, commit.Input) error); ok {
+		r1 = returnFunc(ctx, in)
+	} else {
+		r1 = ret.Error(1)
 	}
-	return r0
+	return r0, r1
 }
 
 // MockInterface_Do_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Do'
@@ -85,12 +96,80 @@ func (_c *MockInterface_Do_Call) Run(run func(ctx context.Context, in commit.Inp
 	return _c
 }
 
-func (_c *MockInterface_Do_Call) Return(err error) *MockInterface_Do_Call {
-	_c.Call.Return(err)
+func (_c *MockInterface_Do_Call) Return(output *commit.Output, err error) *MockInterface_Do_Call {
+	_c.Call.Return(output, err)
+	return _c
+}
+
+func (_c *MockInterface_Do_Call) RunAndReturn(run func(ctx context.Context, in commit.Input) (*commit.Output, error)) *MockInterface_Do_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Plan provides a mock function for the type MockInterface
+func (_mock *MockInterface) Plan(ctx context.Context, in commit.Input) (*commit.PlanOutput, error) {
+	ret := _mock.Called(ctx, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Plan")
+	}
+
+	var r0 *commit.PlanOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, commit.Input) (*commit.PlanOutput, error)); ok {
+		return returnFunc(ctx, in)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, commit.Input) *commit.PlanOutput); ok {
+		r0 = returnFunc(ctx, in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commit.PlanOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, commit.Input) error); ok {
+		r1 = returnFunc(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInterface_Plan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Plan'
+type MockInterface_Plan_Call struct {
+	*mock.Call
+}
+
+// Plan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in commit.Input
+func (_e *MockInterface_Expecter) Plan(ctx any, in any) *MockInterface_Plan_Call {
+	return &MockInterface_Plan_Call{Call: _e.mock.On("Plan", ctx, in)}
+}
+
+func (_c *MockInterface_Plan_Call) Run(run func(ctx context.Context, in commit.Input)) *MockInterface_Plan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 commit.Input
+		if args[1] != nil {
+			arg1 = args[1].(commit.Input)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInterface_Plan_Call) Return(planOutput *commit.PlanOutput, err error) *MockInterface_Plan_Call {
+	_c.Call.Return(planOutput, err)
 	return _c
 }
 
-func (_c *MockInterface_Do_Call) RunAndReturn(run func(ctx context.Context, in commit.Input) error) *MockInterface_Do_Call {
+func (_c *MockInterface_Plan_Call) RunAndReturn(run func(ctx context.Context, in commit.Input) (*commit.PlanOutput, error)) *MockInterface_Plan_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -7,6 +7,7 @@ package forkcommit_mock
 import (
 	"context"
 
+	"github.com/int128/ghcp/pkg/usecases/commit"
 	"github.com/int128/ghcp/pkg/usecases/forkcommit"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -39,20 +40,31 @@ func (_m *MockInterface) EXPECT() *MockInterface_Expecter {
 }
 
 // Do provides a mock function for the type MockInterface
-func (_mock *MockInterface) Do(ctx context.Context, in forkcommit.Input) error {
+func (_mock *MockInterface) Do(ctx context.Context, in forkcommit.Input) (*commit.Output, error) {
 	ret := _mock.Called(ctx, in)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Do")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, forkcommit.Input) error); ok {
+	var r0 *commit.Output
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, forkcommit.Input) (*commit.Output, error)); ok {
+		return returnFunc(ctx, in)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, forkcommit.Input) *commit.Output); ok {
 		r0 = returnFunc(ctx, in)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commit.Output)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, forkcommit.Input) error); ok {
+		r1 = returnFunc(ctx, in)
+	} else {
+		r1 = ret.Error(1)
 	}
-	return r0
+	return r0, r1
 }
 
 // MockInterface_Do_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Do'
@@ -85,12 +97,12 @@ func (_c *MockInterface_Do_Call) Run(run func(ctx context.Context, in forkcommit
 	return _c
 }
 
-func (_c *MockInterface_Do_Call) Return(err error) *MockInterface_Do_Call {
-	_c.Call.Return(err)
+func (_c *MockInterface_Do_Call) Return(output *commit.Output, err error) *MockInterface_Do_Call {
+	_c.Call.Return(output, err)
 	return _c
 }
 
-func (_c *MockInterface_Do_Call) RunAndReturn(run func(ctx context.Context, in forkcommit.Input) error) *MockInterface_Do_Call {
+func (_c *MockInterface_Do_Call) RunAndReturn(run func(ctx context.Context, in forkcommit.Input) (*commit.Output, error)) *MockInterface_Do_Call {
 	_c.Call.Return(run)
 	return _c
 }
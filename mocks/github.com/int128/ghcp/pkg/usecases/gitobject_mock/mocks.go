@@ -38,6 +38,74 @@ func (_m *MockInterface) EXPECT() *MockInterface_Expecter {
 	return &MockInterface_Expecter{mock: &_m.Mock}
 }
 
+// CommitOnBranch provides a mock function for the type MockInterface
+func (_mock *MockInterface) CommitOnBranch(ctx context.Context, in gitobject.CommitOnBranchInput) (*gitobject.Output, error) {
+	ret := _mock.Called(ctx, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitOnBranch")
+	}
+
+	var r0 *gitobject.Output
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, gitobject.CommitOnBranchInput) (*gitobject.Output, error)); ok {
+		return returnFunc(ctx, in)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, gitobject.CommitOnBranchInput) *gitobject.Output); ok {
+		r0 = returnFunc(ctx, in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gitobject.Output)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, gitobject.CommitOnBranchInput) error); ok {
+		r1 = returnFunc(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInterface_CommitOnBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitOnBranch'
+type MockInterface_CommitOnBranch_Call struct {
+	*mock.Call
+}
+
+// CommitOnBranch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in gitobject.CommitOnBranchInput
+func (_e *MockInterface_Expecter) CommitOnBranch(ctx any, in any) *MockInterface_CommitOnBranch_Call {
+	return &MockInterface_CommitOnBranch_Call{Call: _e.mock.On("CommitOnBranch", ctx, in)}
+}
+
+func (_c *MockInterface_CommitOnBranch_Call) Run(run func(ctx context.Context, in gitobject.CommitOnBranchInput)) *MockInterface_CommitOnBranch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 gitobject.CommitOnBranchInput
+		if args[1] != nil {
+			arg1 = args[1].(gitobject.CommitOnBranchInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInterface_CommitOnBranch_Call) Return(output *gitobject.Output, err error) *MockInterface_CommitOnBranch_Call {
+	_c.Call.Return(output, err)
+	return _c
+}
+
+func (_c *MockInterface_CommitOnBranch_Call) RunAndReturn(run func(ctx context.Context, in gitobject.CommitOnBranchInput) (*gitobject.Output, error)) *MockInterface_CommitOnBranch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Do provides a mock function for the type MockInterface
 func (_mock *MockInterface) Do(ctx context.Context, in gitobject.Input) (*gitobject.Output, error) {
 	ret := _mock.Called(ctx, in)
@@ -105,3 +173,71 @@ func (_c *MockInterface_Do_Call) RunAndReturn(run func(ctx context.Context, in g
 	_c.Call.Return(run)
 	return _c
 }
+
+// Plan provides a mock function for the type MockInterface
+func (_mock *MockInterface) Plan(ctx context.Context, in gitobject.Input) (*gitobject.Changes, error) {
+	ret := _mock.Called(ctx, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Plan")
+	}
+
+	var r0 *gitobject.Changes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, gitobject.Input) (*gitobject.Changes, error)); ok {
+		return returnFunc(ctx, in)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, gitobject.Input) *gitobject.Changes); ok {
+		r0 = returnFunc(ctx, in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gitobject.Changes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, gitobject.Input) error); ok {
+		r1 = returnFunc(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInterface_Plan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Plan'
+type MockInterface_Plan_Call struct {
+	*mock.Call
+}
+
+// Plan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in gitobject.Input
+func (_e *MockInterface_Expecter) Plan(ctx any, in any) *MockInterface_Plan_Call {
+	return &MockInterface_Plan_Call{Call: _e.mock.On("Plan", ctx, in)}
+}
+
+func (_c *MockInterface_Plan_Call) Run(run func(ctx context.Context, in gitobject.Input)) *MockInterface_Plan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 gitobject.Input
+		if args[1] != nil {
+			arg1 = args[1].(gitobject.Input)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInterface_Plan_Call) Return(changes *gitobject.Changes, err error) *MockInterface_Plan_Call {
+	_c.Call.Return(changes, err)
+	return _c
+}
+
+func (_c *MockInterface_Plan_Call) RunAndReturn(run func(ctx context.Context, in gitobject.Input) (*gitobject.Changes, error)) *MockInterface_Plan_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -39,20 +39,31 @@ func (_m *MockInterface) EXPECT() *MockInterface_Expecter {
 }
 
 // Do provides a mock function for the type MockInterface
-func (_mock *MockInterface) Do(ctx context.Context, in pullrequest.Input) error {
+func (_mock *MockInterface) Do(ctx context.Context, in pullrequest.Input) (*pullrequest.Output, error) {
 	ret := _mock.Called(ctx, in)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Do")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, pullrequest.Input) error); ok {
+	var r0 *pullrequest.Output
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pullrequest.Input) (*pullrequest.Output, error)); ok {
+		return returnFunc(ctx, in)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pullrequest.Input) *pullrequest.Output); ok {
 		r0 = returnFunc(ctx, in)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pullrequest.Output)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pullrequest.Input) error); ok {
+		r1 = returnFunc(ctx, in)
+	} else {
+		r1 = ret.Error(1)
 	}
-	return r0
+	return r0, r1
 }
 
 // MockInterface_Do_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Do'
@@ -85,12 +96,12 @@ func (_c *MockInterface_Do_Call) Run(run func(ctx context.Context, in pullreques
 	return _c
 }
 
-func (_c *MockInterface_Do_Call) Return(err error) *MockInterface_Do_Call {
-	_c.Call.Return(err)
+func (_c *MockInterface_Do_Call) Return(output *pullrequest.Output, err error) *MockInterface_Do_Call {
+	_c.Call.Return(output, err)
 	return _c
 }
 
-func (_c *MockInterface_Do_Call) RunAndReturn(run func(ctx context.Context, in pullrequest.Input) error) *MockInterface_Do_Call {
+func (_c *MockInterface_Do_Call) RunAndReturn(run func(ctx context.Context, in pullrequest.Input) (*pullrequest.Output, error)) *MockInterface_Do_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/int128/ghcp/pkg/git"
+	"github.com/int128/ghcp/pkg/usecases/cherrypick"
+)
+
+const cherryPickCmdExample = `  To apply the changes of the commit onto the branch:
+    ghcp cherry-pick -r OWNER/REPO -b BRANCH SHA
+
+  To append "(cherry picked from commit SHA)" to the message:
+    ghcp cherry-pick -r OWNER/REPO -b BRANCH -x SHA
+
+  If a file changed by the commit has diverged in the branch, it will fail with the list of the files.`
+
+func (r *Runner) newCherryPickCmd(ctx context.Context, gOpts *globalOptions) *cobra.Command {
+	var o cherryPickOptions
+	c := &cobra.Command{
+		Use:     fmt.Sprintf("%s [flags] SHA", cherryPickCmdName),
+		Short:   "Apply the changes of a commit onto the branch",
+		Long:    `This applies the changes of the commit against its parent onto the branch, with the same message and author.`,
+		Example: cherryPickCmdExample,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			in, err := r.cherryPickInput(&o, gOpts, args[0])
+			if err != nil {
+				return fmt.Errorf("invalid flag: %w", err)
+			}
+
+			ir, err := r.newInternalRunner(gOpts)
+			if err != nil {
+				return fmt.Errorf("error while bootstrap of the dependencies: %w", err)
+			}
+			out, err := ir.CherryPickUseCase.Do(ctx, in)
+			if err != nil {
+				slog.Debug("Stacktrace", "stacktrace", err)
+				return fmt.Errorf("could not cherry-pick the commit: %s", err)
+			}
+			return r.writeResult(gOpts, cherryPickCmdName, newCommitResult(out))
+		},
+	}
+	o.register(c.Flags())
+	return c
+}
+
+// cherryPickOptions represents the options of cherry-pick and revert.
+type cherryPickOptions struct {
+	commitAttributeOptions
+	repositoryOptions
+
+	BranchName   string
+	RecordOrigin bool
+	DryRun       bool
+}
+
+func (o *cherryPickOptions) register(f *pflag.FlagSet) {
+	o.registerBranch(f)
+	f.BoolVarP(&o.RecordOrigin, "record-origin", "x", false, `Append "(cherry picked from commit SHA)" to the message`)
+	o.registerCommitter(f, "login name", "login email")
+}
+
+// registerRevert registers the flags of revert,
+// which creates a commit of the login user as well as git revert.
+func (o *cherryPickOptions) registerRevert(f *pflag.FlagSet) {
+	o.registerBranch(f)
+	o.registerCommitter(f, "login name", "login email")
+}
+
+func (o *cherryPickOptions) registerBranch(f *pflag.FlagSet) {
+	o.repositoryOptions.register(f)
+	f.StringVarP(&o.BranchName, "branch", "b", "", "Name of the branch to update (default: the current branch if the repository is inferred from the git remote, otherwise the default branch of repository)")
+	f.BoolVar(&o.DryRun, "dry-run", false, "Create a commit but do not update the branch actually")
+}
+
+// cherryPickInput validates the options and returns the input of the use case.
+func (r *Runner) cherryPickInput(o *cherryPickOptions, gOpts *globalOptions, commitSHA string) (cherrypick.Input, error) {
+	if err := o.validate(); err != nil {
+		return cherrypick.Input{}, err
+	}
+	defaults, err := r.inferRepository(&o.repositoryOptions, gOpts)
+	if err != nil {
+		return cherrypick.Input{}, err
+	}
+	if o.BranchName == "" {
+		o.BranchName = string(defaults.Branch)
+	}
+	repository, err := o.repositoryID()
+	if err != nil {
+		return cherrypick.Input{}, err
+	}
+	if err := o.resolveDates(r.Env); err != nil {
+		return cherrypick.Input{}, err
+	}
+	return cherrypick.Input{
+		Repository:   repository,
+		BranchName:   git.BranchName(o.BranchName),
+		CommitSHA:    git.CommitSHA(commitSHA),
+		RecordOrigin: o.RecordOrigin,
+		Committer:    o.committer(),
+		DryRun:       o.DryRun,
+	}, nil
+}
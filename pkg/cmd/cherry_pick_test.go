@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/int128/ghcp/mocks/github.com/int128/ghcp/pkg/usecases/cherrypick_mock"
+	"github.com/int128/ghcp/pkg/git"
+	"github.com/int128/ghcp/pkg/github/client"
+	"github.com/int128/ghcp/pkg/usecases/cherrypick"
+	"github.com/int128/ghcp/pkg/usecases/commit"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmd_Run_cherry_pick(t *testing.T) {
+	t.Run("BasicOptions", func(t *testing.T) {
+		cherryPickUseCase := cherrypick_mock.NewMockInterface(t)
+		cherryPickUseCase.EXPECT().
+			Do(mock.Anything, cherrypick.Input{
+				Repository:   git.RepositoryID{Owner: "owner", Name: "repo"},
+				BranchName:   "release",
+				CommitSHA:    "commitSHA",
+				RecordOrigin: true,
+				Committer:    &git.CommitAuthor{Name: "Bob", Email: "bob@example.com"},
+			}).
+			Return(&commit.Output{}, nil)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, map[string]string{envGitHubAPI: ""}),
+			NewInternalRunner: newInternalRunner(InternalRunner{CherryPickUseCase: cherryPickUseCase}),
+		}
+		args := []string{
+			cmdName,
+			cherryPickCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-b", "release",
+			"-x",
+			"--committer-name", "Bob",
+			"--committer-email", "bob@example.com",
+			"commitSHA",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("--committer-date", func(t *testing.T) {
+		cherryPickUseCase := cherrypick_mock.NewMockInterface(t)
+		cherryPickUseCase.EXPECT().
+			Do(mock.Anything, cherrypick.Input{
+				Repository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				BranchName: "release",
+				CommitSHA:  "commitSHA",
+				Committer: &git.CommitAuthor{
+					Name:  "Bob",
+					Email: "bob@example.com",
+					Date:  time.Unix(1700000000, 0).UTC(),
+				},
+			}).
+			Return(&commit.Output{}, nil)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, map[string]string{envGitHubAPI: ""}),
+			NewInternalRunner: newInternalRunner(InternalRunner{CherryPickUseCase: cherryPickUseCase}),
+		}
+		args := []string{
+			cmdName,
+			cherryPickCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-b", "release",
+			"--committer-name", "Bob",
+			"--committer-email", "bob@example.com",
+			"--committer-date", "1700000000",
+			"commitSHA",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("--committer-date without committer", func(t *testing.T) {
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, nil),
+			NewInternalRunner: newInternalRunner(InternalRunner{}),
+		}
+		args := []string{
+			cmdName,
+			cherryPickCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-b", "release",
+			"--committer-date", "1700000000",
+			"commitSHA",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeError {
+			t.Errorf("exitCode wants %d but %d", exitCodeError, exitCode)
+		}
+	})
+
+	t.Run("no commit", func(t *testing.T) {
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, nil),
+			NewInternalRunner: newInternalRunner(InternalRunner{}),
+		}
+		args := []string{
+			cmdName,
+			cherryPickCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-b", "release",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeError {
+			t.Errorf("exitCode wants %d but %d", exitCodeError, exitCode)
+		}
+	})
+}
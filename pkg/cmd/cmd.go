@@ -9,7 +9,10 @@ import (
 
 	"github.com/google/wire"
 	"github.com/int128/ghcp/pkg/env"
+	"github.com/int128/ghcp/pkg/fs"
+	"github.com/int128/ghcp/pkg/git"
 	"github.com/int128/ghcp/pkg/github/client"
+	"github.com/int128/ghcp/pkg/usecases/cherrypick"
 	"github.com/int128/ghcp/pkg/usecases/commit"
 	"github.com/int128/ghcp/pkg/usecases/forkcommit"
 	"github.com/int128/ghcp/pkg/usecases/pullrequest"
@@ -22,12 +25,22 @@ const (
 	envGitHubToken = "GITHUB_TOKEN"
 	envGitHubAPI   = "GITHUB_API"
 
+	// https://docs.github.com/en/actions/reference/variables-reference
+	envGitHubActions    = "GITHUB_ACTIONS"
+	envGitHubRepository = "GITHUB_REPOSITORY"
+	envGitHubAPIURL     = "GITHUB_API_URL"
+	envGitHubSHA        = "GITHUB_SHA"
+
+	defaultGitHubAPIURL = "https://api.github.com"
+
 	exitCodeOK    = 0
 	exitCodeError = 1
 
 	commitCmdName      = "commit"
 	emptyCommitCmdName = "empty-commit"
 	forkCommitCmdName  = "fork-commit"
+	cherryPickCmdName  = "cherry-pick"
+	revertCmdName      = "revert"
 	pullRequestCmdName = "pull-request"
 	releaseCmdName     = "release"
 )
@@ -46,6 +59,7 @@ type Interface interface {
 // It bootstraps the InternalRunner and runs the specified use-case.
 type Runner struct {
 	Env               env.Interface
+	FileSystem        fs.Interface
 	NewGitHub         client.NewFunc
 	NewInternalRunner NewInternalRunnerFunc
 }
@@ -62,6 +76,10 @@ func (r *Runner) Run(args []string, version string) int {
 	rootCmd.AddCommand(emptyCommitCmd)
 	forkCommitCmd := r.newForkCommitCmd(ctx, &o)
 	rootCmd.AddCommand(forkCommitCmd)
+	cherryPickCmd := r.newCherryPickCmd(ctx, &o)
+	rootCmd.AddCommand(cherryPickCmd)
+	revertCmd := r.newRevertCmd(ctx, &o)
+	rootCmd.AddCommand(revertCmd)
 	pullRequestCmd := r.newPullRequestCmd(ctx, &o)
 	rootCmd.AddCommand(pullRequestCmd)
 	releaseCmd := r.newReleaseCmd(ctx, &o)
@@ -79,6 +97,7 @@ type globalOptions struct {
 	Chdir       string
 	GitHubToken string
 	GitHubAPI   string // optional
+	Output      string // optional
 	Debug       bool
 }
 
@@ -86,6 +105,7 @@ func (o *globalOptions) register(f *pflag.FlagSet) {
 	f.StringVarP(&o.Chdir, "directory", "C", "", "Change to directory before operation")
 	f.StringVar(&o.GitHubToken, "token", "", fmt.Sprintf("GitHub API token [$%s]", envGitHubToken))
 	f.StringVar(&o.GitHubAPI, "api", "", fmt.Sprintf("GitHub API v3 URL (v4 will be inferred) [$%s]", envGitHubAPI))
+	f.StringVar(&o.Output, "output", "", fmt.Sprintf("Write the result to standard output in the format (%s)", outputJSON))
 	f.BoolVar(&o.Debug, "debug", false, "Show debug logs")
 }
 
@@ -94,6 +114,9 @@ func (r *Runner) newRootCmd(o *globalOptions) *cobra.Command {
 		Use:          "ghcp",
 		Short:        "A command to commit files to a GitHub repository",
 		SilenceUsage: true,
+		PersistentPreRunE: func(*cobra.Command, []string) error {
+			return r.setup(o)
+		},
 	}
 	o.register(c.PersistentFlags())
 	return c
@@ -105,21 +128,31 @@ type NewInternalRunnerFunc func(client.Interface) *InternalRunner
 type InternalRunner struct {
 	CommitUseCase      commit.Interface
 	ForkCommitUseCase  forkcommit.Interface
+	CherryPickUseCase  cherrypick.Interface
 	PullRequestUseCase pullrequest.Interface
 	ReleaseUseCase     release.Interface
 }
 
-func (r *Runner) newInternalRunner(o *globalOptions) (*InternalRunner, error) {
+// setup configures the logger and changes the directory before the subcommand,
+// so that the local files are resolved from the directory.
+func (r *Runner) setup(o *globalOptions) error {
 	log.SetFlags(log.Lmicroseconds)
 	if o.Debug {
 		slog.SetLogLoggerLevel(slog.LevelDebug)
 	}
+	if o.Output != "" && o.Output != outputJSON {
+		return fmt.Errorf("--output must be %s but was %s", outputJSON, o.Output)
+	}
 	if o.Chdir != "" {
 		if err := r.Env.Chdir(o.Chdir); err != nil {
-			return nil, fmt.Errorf("could not change to directory %s: %w", o.Chdir, err)
+			return fmt.Errorf("could not change to directory %s: %w", o.Chdir, err)
 		}
 		slog.Info("Changed to directory", "directory", o.Chdir)
 	}
+	return nil
+}
+
+func (r *Runner) newInternalRunner(o *globalOptions) (*InternalRunner, error) {
 	if o.GitHubToken == "" {
 		o.GitHubToken = r.Env.Getenv(envGitHubToken)
 		if o.GitHubToken != "" {
@@ -129,12 +162,7 @@ func (r *Runner) newInternalRunner(o *globalOptions) (*InternalRunner, error) {
 	if o.GitHubToken == "" {
 		return nil, fmt.Errorf("no GitHub API token. Set environment variable %s or --token option", envGitHubToken)
 	}
-	if o.GitHubAPI == "" {
-		o.GitHubAPI = r.Env.Getenv(envGitHubAPI)
-		if o.GitHubAPI != "" {
-			slog.Debug("Using GitHub Enterprise URL from environment variable", "variable", envGitHubAPI)
-		}
-	}
+	r.resolveGitHubAPI(o)
 	gh, err := r.NewGitHub(client.Option{
 		Token: o.GitHubToken,
 		URLv3: o.GitHubAPI,
@@ -144,3 +172,43 @@ func (r *Runner) newInternalRunner(o *globalOptions) (*InternalRunner, error) {
 	}
 	return r.NewInternalRunner(gh), nil
 }
+
+// resolveGitHubAPI sets the GitHub API URL from the environment variables, if --api is not set.
+// On GitHub Actions, it falls back to the API URL of the workflow.
+func (r *Runner) resolveGitHubAPI(o *globalOptions) {
+	if o.GitHubAPI != "" {
+		return
+	}
+	o.GitHubAPI = r.Env.Getenv(envGitHubAPI)
+	if o.GitHubAPI != "" {
+		slog.Debug("Using GitHub Enterprise URL from environment variable", "variable", envGitHubAPI)
+		return
+	}
+	if !r.inGitHubActions() {
+		return
+	}
+	apiURL := r.Env.Getenv(envGitHubAPIURL)
+	if apiURL == "" || apiURL == defaultGitHubAPIURL {
+		return
+	}
+	o.GitHubAPI = apiURL
+	slog.Debug("Using GitHub Enterprise URL of GitHub Actions", "variable", envGitHubAPIURL, "url", apiURL)
+}
+
+// inGitHubActions returns true if the command is running on GitHub Actions.
+func (r *Runner) inGitHubActions() bool {
+	return r.Env.Getenv(envGitHubActions) == "true"
+}
+
+// workflowCommit returns the commit which triggered the workflow of GitHub Actions.
+func (r *Runner) workflowCommit() (git.CommitSHA, error) {
+	if !r.inGitHubActions() {
+		return "", fmt.Errorf("you need to run on GitHub Actions to set --workflow-parent")
+	}
+	commit := r.Env.Getenv(envGitHubSHA)
+	if commit == "" {
+		return "", fmt.Errorf("you need to set %s to set --workflow-parent", envGitHubSHA)
+	}
+	slog.Debug("Using the commit of GitHub Actions as the parent of a new branch", "variable", envGitHubSHA, "commit", commit)
+	return git.CommitSHA(commit), nil
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -29,10 +30,10 @@ func TestCmd_Run(t *testing.T) {
 	t.Run("--debug", func(t *testing.T) {
 		commitUseCase := commit_mock.NewMockInterface(t)
 		commitUseCase.EXPECT().
-			Do(mock.Anything, input).Return(nil)
+			Do(mock.Anything, input).Return(&commit.Output{}, nil)
 		r := Runner{
 			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
-			Env:               newEnv(t, map[string]string{envGitHubAPI: ""}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""}),
 			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
 		}
 		args := []string{
@@ -55,8 +56,8 @@ func TestCmd_Run(t *testing.T) {
 	t.Run("--directory", func(t *testing.T) {
 		commitUseCase := commit_mock.NewMockInterface(t)
 		commitUseCase.EXPECT().
-			Do(mock.Anything, input).Return(nil)
-		mockEnv := newEnv(t, map[string]string{envGitHubAPI: ""})
+			Do(mock.Anything, input).Return(&commit.Output{}, nil)
+		mockEnv := newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""})
 		mockEnv.EXPECT().
 			Chdir("dir").Return(nil)
 		r := Runner{
@@ -84,10 +85,10 @@ func TestCmd_Run(t *testing.T) {
 	t.Run("env/GITHUB_TOKEN", func(t *testing.T) {
 		commitUseCase := commit_mock.NewMockInterface(t)
 		commitUseCase.EXPECT().
-			Do(mock.Anything, input).Return(nil)
+			Do(mock.Anything, input).Return(&commit.Output{}, nil)
 		r := Runner{
 			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
-			Env:               newEnv(t, map[string]string{envGitHubToken: "YOUR_TOKEN", envGitHubAPI: ""}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubToken: "YOUR_TOKEN", envGitHubAPI: ""}),
 			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
 		}
 		args := []string{
@@ -108,7 +109,7 @@ func TestCmd_Run(t *testing.T) {
 	t.Run("NoGitHubToken", func(t *testing.T) {
 		r := Runner{
 			NewGitHub:         newGitHub(t, client.Option{}),
-			Env:               newEnv(t, map[string]string{envGitHubToken: ""}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubToken: ""}),
 			NewInternalRunner: newInternalRunner(InternalRunner{}),
 		}
 		args := []string{
@@ -129,10 +130,10 @@ func TestCmd_Run(t *testing.T) {
 	t.Run("--api", func(t *testing.T) {
 		commitUseCase := commit_mock.NewMockInterface(t)
 		commitUseCase.EXPECT().
-			Do(mock.Anything, input).Return(nil)
+			Do(mock.Anything, input).Return(&commit.Output{}, nil)
 		r := Runner{
 			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN", URLv3: "https://github.example.com/api/v3/"}),
-			Env:               newEnv(t, nil),
+			Env:               newEnv(t, map[string]string{envSigningKey: ""}),
 			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
 		}
 		args := []string{
@@ -155,10 +156,141 @@ func TestCmd_Run(t *testing.T) {
 	t.Run("env/GITHUB_API", func(t *testing.T) {
 		commitUseCase := commit_mock.NewMockInterface(t)
 		commitUseCase.EXPECT().
-			Do(mock.Anything, input).Return(nil)
+			Do(mock.Anything, input).Return(&commit.Output{}, nil)
 		r := Runner{
 			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN", URLv3: "https://github.example.com/api/v3/"}),
-			Env:               newEnv(t, map[string]string{envGitHubAPI: "https://github.example.com/api/v3/"}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: "https://github.example.com/api/v3/"}),
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-u", "owner",
+			"-r", "repo",
+			"-m", "commit-message",
+			"file1",
+			"file2",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("--output json", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(mock.Anything, input).
+			Return(&commit.Output{
+				Repository:   git.RepositoryID{Owner: "owner", Name: "repo"},
+				BranchName:   "main",
+				CommitSHA:    "COMMIT_SHA",
+				ChangedFiles: 2,
+			}, nil)
+		var stdout bytes.Buffer
+		mockEnv := newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""})
+		mockEnv.EXPECT().Stdout().Return(&stdout)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               mockEnv,
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"--output", "json",
+			"-u", "owner",
+			"-r", "repo",
+			"-m", "commit-message",
+			"file1",
+			"file2",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+		want := `{
+  "repository": "owner/repo",
+  "branch": "main",
+  "commit_sha": "COMMIT_SHA",
+  "changed_files": 2,
+  "deleted_files": 0,
+  "branch_created": false
+}
+`
+		if diff := cmp.Diff(want, stdout.String()); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("--output yaml", func(t *testing.T) {
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{}),
+			Env:               newEnv(t, nil),
+			NewInternalRunner: newInternalRunner(InternalRunner{}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--output", "yaml",
+			"-u", "owner",
+			"-r", "repo",
+			"-m", "commit-message",
+			"file1",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeError {
+			t.Errorf("exitCode wants %d but %d", exitCodeError, exitCode)
+		}
+	})
+
+	t.Run("GitHub Actions outputs", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(mock.Anything, input).
+			Return(&commit.Output{
+				Repository:    git.RepositoryID{Owner: "owner", Name: "repo"},
+				BranchName:    "topic",
+				CommitSHA:     "COMMIT_SHA",
+				ChangedFiles:  2,
+				BranchCreated: true,
+			}, nil)
+		mockEnv := newEnv(t, map[string]string{
+			envSigningKey:        "",
+			envGitHubAPI:         "",
+			envGitHubActions:     "true",
+			envGitHubAPIURL:      "https://api.github.com",
+			envGitHubOutput:      "/github/output",
+			envGitHubStepSummary: "/github/step_summary",
+		})
+		mockEnv.EXPECT().
+			AppendFile("/github/output", []byte(`repository=owner/repo
+branch=topic
+commit_sha=COMMIT_SHA
+changed_files=2
+deleted_files=0
+branch_created=true
+`)).
+			Return(nil)
+		mockEnv.EXPECT().
+			AppendFile("/github/step_summary", []byte(`### ghcp commit
+
+| Key | Value |
+| --- | --- |
+| repository | owner/repo |
+| branch | topic |
+| commit_sha | COMMIT_SHA |
+| changed_files | 2 |
+| deleted_files | 0 |
+| branch_created | true |
+
+`)).
+			Return(nil)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               mockEnv,
 			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
 		}
 		args := []string{
@@ -192,6 +324,13 @@ func newEnv(t *testing.T, getenv map[string]string) *env_mock.MockInterface {
 	for k, v := range getenv {
 		env.EXPECT().Getenv(k).Return(v)
 	}
+	if _, ok := getenv[envGitHubActions]; !ok {
+		// not on GitHub Actions unless the test sets it
+		env.EXPECT().Getenv(envGitHubActions).Return("").Maybe()
+	}
+	if _, ok := getenv[envSourceDateEpoch]; !ok {
+		env.EXPECT().Getenv(envSourceDateEpoch).Return("").Maybe()
+	}
 	return env
 }
 
@@ -25,12 +25,48 @@ const commitCmdExample = `  To commit files to the default branch:
   To commit files to a new branch from the parent branch:
     ghcp commit -r OWNER/REPO -b BRANCH --parent PARENT -m MESSAGE FILES...
 
-  If the branch exists, it will fail.
+  If the branch exists, it will fail unless --force or --force-with-lease is set.
+
+  To rebase the existing branch on the parent branch:
+    ghcp commit -r OWNER/REPO -b BRANCH --parent PARENT --force -m MESSAGE FILES...
+
+  To rebase the existing branch on the parent branch, only if the branch points to the commit:
+    ghcp commit -r OWNER/REPO -b BRANCH --parent PARENT --force-with-lease SHA -m MESSAGE FILES...
+
+  If another commit has been pushed to the branch after ghcp read it, the force update will fail.
+
+  To commit the files in build/out directory into docs directory of the repository:
+    ghcp commit -r OWNER/REPO -b BRANCH --strip-prefix build/out --dest-dir docs -m MESSAGE build/out
+    ghcp commit -r OWNER/REPO -b BRANCH --map build/out:docs -m MESSAGE build/out
+
+  To delete files from the branch:
+    ghcp commit -r OWNER/REPO -b BRANCH --delete PATH1 --delete PATH2 -m MESSAGE
+
+  To make a directory of the branch exactly match the local directory:
+    ghcp commit -r OWNER/REPO -b BRANCH --sync -m MESSAGE DIR
+
+  To commit the files except the logs:
+    ghcp commit -r OWNER/REPO -b BRANCH --exclude '**/*.log' -m MESSAGE FILES...
+
+  To store the files matched to filter=lfs in .gitattributes in Git LFS:
+    ghcp commit -r OWNER/REPO -b BRANCH --lfs -m MESSAGE FILES...
+
+  To show the changes to the branch without creating any object:
+    ghcp commit -r OWNER/REPO -b BRANCH --plan -m MESSAGE FILES...
+
+  To create a merge commit of the branch and the ref, with the files applied onto the branch:
+    ghcp commit -r OWNER/REPO -b BRANCH --merge REF -m MESSAGE FILES...
+
+  To create a merge commit with the files applied onto the ref instead:
+    ghcp commit -r OWNER/REPO -b BRANCH --merge REF --merge-tree theirs -m MESSAGE FILES...
+
+  To commit files and keep only the last 10 commits in the branch, e.g. generated artifacts:
+    ghcp commit -r OWNER/REPO -b BRANCH --keep-last 10 -m MESSAGE FILES...
 
   To commit files to a new branch without any parent:
     ghcp commit -r OWNER/REPO -b BRANCH --no-parent -m MESSAGE FILES...
 
-  If the branch exists, it will fail.`
+  If the branch exists, it will fail unless --force or --force-with-lease is set.`
 
 func (r *Runner) newCommitCmd(ctx context.Context, gOpts *globalOptions) *cobra.Command {
 	var o commitOptions
@@ -40,13 +76,45 @@ func (r *Runner) newCommitCmd(ctx context.Context, gOpts *globalOptions) *cobra.
 		Long:    `This commits the files to the branch. This will create a branch if it does not exist.`,
 		Example: commitCmdExample,
 		RunE: func(_ *cobra.Command, args []string) error {
+			if err := o.readGitConfig(r.FileSystem); err != nil {
+				return err
+			}
 			if err := o.validate(); err != nil {
 				return fmt.Errorf("invalid flag: %w", err)
 			}
+			defaults, err := r.inferRepository(&o.repositoryOptions, gOpts)
+			if err != nil {
+				return fmt.Errorf("invalid flag: %w", err)
+			}
+			if o.BranchName == "" {
+				o.BranchName = string(defaults.Branch)
+			}
+			var newBranchParent git.CommitSHA
+			if o.WorkflowParent {
+				if newBranchParent, err = r.workflowCommit(); err != nil {
+					return fmt.Errorf("invalid flag: %w", err)
+				}
+			}
 			targetRepository, err := o.repositoryID()
 			if err != nil {
 				return fmt.Errorf("invalid flag: %w", err)
 			}
+			pathMapping, err := o.pathMapping()
+			if err != nil {
+				return fmt.Errorf("invalid flag: %w", err)
+			}
+
+			commitMessage, err := o.commitMessage(r.FileSystem)
+			if err != nil {
+				return fmt.Errorf("invalid flag: %w", err)
+			}
+			if err := o.resolveDates(r.Env); err != nil {
+				return fmt.Errorf("invalid flag: %w", err)
+			}
+			signingKey, err := o.signingKey(r.Env)
+			if err != nil {
+				return fmt.Errorf("invalid flag: %w", err)
+			}
 
 			ir, err := r.newInternalRunner(gOpts)
 			if err != nil {
@@ -57,18 +125,42 @@ func (r *Runner) newCommitCmd(ctx context.Context, gOpts *globalOptions) *cobra.
 				TargetBranchName: git.BranchName(o.BranchName),
 				ParentRepository: targetRepository,
 				CommitStrategy:   o.commitStrategy(),
-				CommitMessage:    git.CommitMessage(o.CommitMessage),
+				NewBranchParent:  newBranchParent,
+				CommitMessage:    commitMessage,
+				MessageTemplate:  o.MessageTemplate,
 				Author:           o.author(),
 				Committer:        o.committer(),
+				SigningKey:       signingKey,
 				Paths:            args,
+				PathMapping:      pathMapping,
+				DeletePaths:      o.DeletePaths,
+				Sync:             o.Sync,
 				NoFileMode:       o.NoFileMode,
+				Parallelism:      o.Parallelism,
+				LFS:              o.LFS,
+				GitIgnore:        o.GitIgnore,
+				Include:          o.Include,
+				Exclude:          o.Exclude,
+				ForceUpdate:      o.Force || o.ForceWithLease != "",
+				Lease:            git.CommitSHA(o.ForceWithLease),
+				Retry:            o.Retry,
+				GraphQL:          o.GraphQL,
 				DryRun:           o.DryRun,
 			}
-			if err := ir.CommitUseCase.Do(ctx, in); err != nil {
+			if o.Plan {
+				plan, err := ir.CommitUseCase.Plan(ctx, in)
+				if err != nil {
+					slog.Debug("Stacktrace", "stacktrace", err)
+					return fmt.Errorf("could not plan the commit: %s", err)
+				}
+				return r.writePlan(gOpts, plan)
+			}
+			out, err := ir.CommitUseCase.Do(ctx, in)
+			if err != nil {
 				slog.Debug("Stacktrace", "stacktrace", err)
 				return fmt.Errorf("could not commit the files: %s", err)
 			}
-			return nil
+			return r.writeResult(gOpts, commitCmdName, newCommitResult(out))
 		},
 	}
 	o.register(c.Flags())
@@ -79,17 +171,63 @@ type commitOptions struct {
 	commitAttributeOptions
 	repositoryOptions
 
-	BranchName string
-	ParentRef  string
-	NoParent   bool
-	NoFileMode bool
-	DryRun     bool
+	BranchName     string
+	ParentRef      string
+	WorkflowParent bool
+	NoParent       bool
+	MergeRef       string
+	MergeTree      string
+	KeepLast       int
+	DestDir        string
+	StripPrefix    string
+	PathMaps       []string
+	DeletePaths    []string
+	Sync           bool
+	NoFileMode     bool
+	Parallelism    int
+	LFS            bool
+	GitIgnore      bool
+	Include        []string
+	Exclude        []string
+	Force          bool
+	ForceWithLease string
+	Retry          int
+	GraphQL        bool
+	DryRun         bool
+	Plan           bool
 }
 
 func (o commitOptions) validate() error {
 	if o.ParentRef != "" && o.NoParent {
 		return fmt.Errorf("do not set both --parent and --no-parent")
 	}
+	if len(o.DeletePaths) > 0 && o.NoParent {
+		return fmt.Errorf("do not set both --delete and --no-parent")
+	}
+	if o.MergeRef != "" && (o.ParentRef != "" || o.NoParent) {
+		return fmt.Errorf("do not set both --merge and --parent or --no-parent")
+	}
+	if o.WorkflowParent && (o.ParentRef != "" || o.NoParent || o.MergeRef != "") {
+		return fmt.Errorf("do not set both --workflow-parent and --parent, --no-parent or --merge")
+	}
+	if err := validateMergeTree(o.MergeRef, o.MergeTree); err != nil {
+		return err
+	}
+	if o.KeepLast < 0 {
+		return fmt.Errorf("--keep-last must be positive")
+	}
+	if o.KeepLast > 0 && (o.ParentRef != "" || o.NoParent || o.MergeRef != "") {
+		return fmt.Errorf("do not set both --keep-last and --parent, --no-parent or --merge")
+	}
+	if o.Force && o.ForceWithLease != "" {
+		return fmt.Errorf("do not set both --force and --force-with-lease")
+	}
+	if o.Retry < 0 {
+		return fmt.Errorf("--retry must be positive")
+	}
+	if o.Parallelism < 0 {
+		return fmt.Errorf("--parallelism must be positive")
+	}
 	if err := o.commitAttributeOptions.validate(); err != nil {
 		return fmt.Errorf("%w", err)
 	}
@@ -103,15 +241,79 @@ func (o commitOptions) commitStrategy() commitstrategy.CommitStrategy {
 	if o.ParentRef != "" {
 		return commitstrategy.RebaseOn(git.RefName(o.ParentRef))
 	}
+	if o.MergeRef != "" {
+		return mergeStrategy(o.MergeRef, o.MergeTree)
+	}
+	if o.KeepLast > 0 {
+		return commitstrategy.FastForwardKeepLast(o.KeepLast)
+	}
 	return commitstrategy.FastForward
 }
 
+// validateMergeTree returns an error if --merge-tree is invalid.
+func validateMergeTree(mergeRef, mergeTree string) error {
+	if mergeTree == "" {
+		return nil
+	}
+	if mergeRef == "" {
+		return fmt.Errorf("you need to set --merge to set --merge-tree")
+	}
+	switch commitstrategy.MergeSide(mergeTree) {
+	case commitstrategy.Ours, commitstrategy.Theirs:
+		return nil
+	}
+	return fmt.Errorf("--merge-tree must be %s or %s", commitstrategy.Ours, commitstrategy.Theirs)
+}
+
+// mergeStrategy returns the strategy of a merge commit, which applies the files onto the branch by default.
+func mergeStrategy(mergeRef, mergeTree string) commitstrategy.CommitStrategy {
+	side := commitstrategy.Ours
+	if mergeTree != "" {
+		side = commitstrategy.MergeSide(mergeTree)
+	}
+	return commitstrategy.MergeWith(git.RefName(mergeRef), side)
+}
+
+func (o commitOptions) pathMapping() (commit.PathMapping, error) {
+	m := commit.PathMapping{
+		StripPrefix: o.StripPrefix,
+		DestDir:     o.DestDir,
+	}
+	for _, s := range o.PathMaps {
+		rule, err := commit.ParsePathMappingRule(s)
+		if err != nil {
+			return commit.PathMapping{}, fmt.Errorf("invalid --map: %w", err)
+		}
+		m.Rules = append(m.Rules, rule)
+	}
+	return m, nil
+}
+
 func (o *commitOptions) register(f *pflag.FlagSet) {
 	o.repositoryOptions.register(f)
-	f.StringVarP(&o.BranchName, "branch", "b", "", "Name of the branch to create or update (default: the default branch of repository)")
+	f.StringVarP(&o.BranchName, "branch", "b", "", "Name of the branch to create or update (default: the current branch if the repository is inferred from the git remote, otherwise the default branch of repository)")
 	f.StringVar(&o.ParentRef, "parent", "", "Create a commit from the parent branch/tag (default: fast-forward)")
+	f.BoolVar(&o.WorkflowParent, "workflow-parent", false, fmt.Sprintf("Create a new branch from the commit which triggered the workflow ($%s) instead of the default branch. An existing branch is updated by fast-forward", envGitHubSHA))
 	f.BoolVar(&o.NoParent, "no-parent", false, "Create a commit without a parent")
+	f.StringVar(&o.MergeRef, "merge", "", "Create a merge commit of the branch and the branch/tag")
+	f.StringVar(&o.MergeTree, "merge-tree", "", "Tree of the merge commit to apply the files onto, ours or theirs (default: ours)")
+	f.IntVar(&o.KeepLast, "keep-last", 0, "Rewrite the branch to keep only the last N commits including the new commit (default: keep all)")
+	f.StringVar(&o.DestDir, "dest-dir", "", "Directory in the repository to put the files into (default: root of the repository)")
+	f.StringVar(&o.StripPrefix, "strip-prefix", "", "Strip the prefix from the local paths")
+	f.StringArrayVar(&o.PathMaps, "map", nil, "Map the local path to the path in the repository, in form of SRC:DEST (multiple)")
+	f.StringArrayVar(&o.DeletePaths, "delete", nil, "Path of the file or directory to delete from the branch (multiple)")
+	f.BoolVar(&o.Sync, "sync", false, "Delete files under the given paths in the branch which do not exist locally")
 	f.BoolVar(&o.NoFileMode, "no-file-mode", false, "Ignore executable bit of file and treat as 0644")
+	f.IntVar(&o.Parallelism, "parallelism", 0, "Number of files to upload concurrently (default: 1)")
+	f.BoolVar(&o.LFS, "lfs", false, "Upload files matched to filter=lfs in .gitattributes to Git LFS")
+	f.BoolVar(&o.GitIgnore, "gitignore", false, "Exclude files ignored by .gitignore and .git/info/exclude")
+	f.StringArrayVar(&o.Include, "include", nil, "Glob pattern of the files to include, e.g. dist/**/*.tar.gz (multiple)")
+	f.StringArrayVar(&o.Exclude, "exclude", nil, "Glob pattern of the files or directories to exclude (multiple)")
+	f.BoolVar(&o.Force, "force", false, "Update the branch even if it is not fast-forward, only if it has not moved since ghcp read it")
+	f.StringVar(&o.ForceWithLease, "force-with-lease", "", "Update the branch even if it is not fast-forward, only if it points to the commit SHA")
+	f.IntVar(&o.Retry, "retry", 0, "Number of retries when another commit has been pushed to the branch")
+	f.BoolVar(&o.GraphQL, "graphql", false, "Create a commit by the GraphQL createCommitOnBranch mutation, which is verified for a GitHub App")
+	f.BoolVar(&o.Plan, "plan", false, "Show the changes to the branch without calling any write API")
 	f.BoolVar(&o.DryRun, "dry-run", false, "Upload files but do not update the branch actually")
 	o.commitAttributeOptions.register(f)
 }
@@ -2,42 +2,246 @@ package cmd
 
 import (
 	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/spf13/pflag"
 
+	"github.com/int128/ghcp/pkg/env"
+	"github.com/int128/ghcp/pkg/fs"
 	"github.com/int128/ghcp/pkg/git"
 )
 
+const (
+	envSigningKey    = "GHCP_SIGNING_KEY"
+	envSigningFormat = "GHCP_SIGNING_FORMAT"
+
+	// https://reproducible-builds.org/specs/source-date-epoch/
+	envSourceDateEpoch = "SOURCE_DATE_EPOCH"
+)
+
 type commitAttributeOptions struct {
-	CommitMessage  string
-	AuthorName     string
-	AuthorEmail    string
-	CommitterName  string
-	CommitterEmail string
+	CommitMessage     string
+	CommitMessageFile string
+	MessageTemplate   bool
+	Trailers          []string
+	CoAuthors         []string
+	SignOff           bool
+	AuthorName        string
+	AuthorEmail       string
+	CommitterName     string
+	CommitterEmail    string
+	GitConfig         bool
+	AuthorDate        string
+	CommitterDate     string
+	SigningKey        string
+	SigningFormat     string
+
+	authorDate    time.Time // resolved by resolveDates
+	committerDate time.Time // resolved by resolveDates
 }
 
 func (o *commitAttributeOptions) register(f *pflag.FlagSet) {
 	f.StringVarP(&o.CommitMessage, "message", "m", "", "Commit message (mandatory)")
+	f.StringVarP(&o.CommitMessageFile, "file", "F", "", "Read the commit message from the file, or standard input if -")
+	f.BoolVar(&o.MessageTemplate, "message-template", false, "Render the commit message as a Go template")
+	f.StringArrayVar(&o.Trailers, "trailer", nil, "Add a trailer to the commit message, in form of KEY=VALUE (multiple)")
+	f.StringArrayVar(&o.CoAuthors, "co-author", nil, "Add a Co-authored-by trailer, in form of \"Name <email>\" (multiple)")
+	f.BoolVar(&o.SignOff, "signoff", false, "Add a Signed-off-by trailer of the committer or author")
 	f.StringVarP(&o.AuthorName, "author-name", "", "", "Author name (default: login name)")
 	f.StringVarP(&o.AuthorEmail, "author-email", "", "", "Author email (default: login email)")
-	f.StringVarP(&o.CommitterName, "committer-name", "", "", "Committer name (default: login name)")
-	f.StringVarP(&o.CommitterEmail, "committer-email", "", "", "Committer email (default: login email)")
+	o.registerCommitter(f, "login name", "login email")
+	f.BoolVar(&o.GitConfig, "git-config", false, "Use user.name and user.email in the git config as the author (default: login user)")
+	f.StringVar(&o.AuthorDate, "author-date", "", fmt.Sprintf("Author date in RFC 3339 or Unix time (default: now) [$%s if the author or committer is set]", envSourceDateEpoch))
+	f.StringVar(&o.SigningKey, "signing-key", "", fmt.Sprintf("Sign the commit with the key ID of OpenPGP or path to the SSH key [$%s]", envSigningKey))
+	f.StringVar(&o.SigningFormat, "signing-format", "", fmt.Sprintf("Format of the signature, openpgp or ssh (default: openpgp) [$%s]", envSigningFormat))
+}
+
+// registerCommitter registers only the flags of the committer,
+// for a command which takes the message and author from an existing commit.
+func (o *commitAttributeOptions) registerCommitter(f *pflag.FlagSet, defaultName, defaultEmail string) {
+	f.StringVarP(&o.CommitterName, "committer-name", "", "", fmt.Sprintf("Committer name (default: %s)", defaultName))
+	f.StringVarP(&o.CommitterEmail, "committer-email", "", "", fmt.Sprintf("Committer email (default: %s)", defaultEmail))
+	f.StringVar(&o.CommitterDate, "committer-date", "", fmt.Sprintf("Committer date in RFC 3339 or Unix time (default: now) [$%s if the author or committer is set]", envSourceDateEpoch))
 }
 
 func (o *commitAttributeOptions) validate() error {
+	if o.CommitMessage != "" && o.CommitMessageFile != "" {
+		return fmt.Errorf("do not set both --message and --file")
+	}
+	if o.SignOff && o.AuthorName == "" && o.CommitterName == "" {
+		return fmt.Errorf("you need to set the committer or author to set --signoff")
+	}
 	if (o.AuthorName == "" && o.AuthorEmail != "") || (o.AuthorName != "" && o.AuthorEmail == "") {
 		return fmt.Errorf("you need to set both --author-name and --author-email")
 	}
 	if (o.CommitterName == "" && o.CommitterEmail != "") || (o.CommitterName != "" && o.CommitterEmail == "") {
 		return fmt.Errorf("you need to set both --committer-name and --committer-email")
 	}
+	if o.AuthorDate != "" && o.AuthorName == "" {
+		return fmt.Errorf("you need to set --author-name and --author-email to set --author-date")
+	}
+	if o.CommitterDate != "" && o.CommitterName == "" && o.AuthorName == "" {
+		return fmt.Errorf("you need to set --committer-name and --committer-email or the author to set --committer-date")
+	}
+	return nil
+}
+
+// readGitConfig sets the author to user.name and user.email in the git config,
+// if --git-config is set and neither the author name nor email is given by the flags.
+func (o *commitAttributeOptions) readGitConfig(f fs.Interface) error {
+	if !o.GitConfig || o.AuthorName != "" || o.AuthorEmail != "" {
+		return nil
+	}
+	gitConfig, err := f.ReadGitConfig()
+	if err != nil {
+		return fmt.Errorf("could not read the git config: %w", err)
+	}
+	if gitConfig.UserName == "" || gitConfig.UserEmail == "" {
+		slog.Warn("Using the login user as the author because user.name or user.email is not set in the git config")
+		return nil
+	}
+	o.AuthorName, o.AuthorEmail = gitConfig.UserName, gitConfig.UserEmail
+	slog.Debug("Using the author from the git config", "name", o.AuthorName, "email", o.AuthorEmail)
+	return nil
+}
+
+// commitMessage returns the commit message given by --message or --file,
+// with the trailers given by the flags.
+func (o *commitAttributeOptions) commitMessage(f fs.Interface) (git.CommitMessage, error) {
+	message, err := o.readCommitMessage(f)
+	if err != nil {
+		return "", err
+	}
+	if message == "" {
+		return "", nil
+	}
+	trailers, err := o.trailers()
+	if err != nil {
+		return "", err
+	}
+	return message.WithTrailers(trailers...), nil
+}
+
+func (o *commitAttributeOptions) readCommitMessage(f fs.Interface) (git.CommitMessage, error) {
+	if o.CommitMessageFile == "" {
+		return git.CommitMessage(o.CommitMessage), nil
+	}
+	var b []byte
+	var err error
+	if o.CommitMessageFile == "-" {
+		b, err = io.ReadAll(f.Stdin())
+	} else {
+		b, err = f.ReadFile(o.CommitMessageFile)
+	}
+	if err != nil {
+		return "", fmt.Errorf("could not read the commit message: %w", err)
+	}
+	return git.CommitMessage(strings.TrimSpace(string(b))), nil
+}
+
+// trailers returns the trailers in order of --trailer, --co-author and --signoff.
+func (o *commitAttributeOptions) trailers() ([]git.Trailer, error) {
+	var trailers []git.Trailer
+	for _, s := range o.Trailers {
+		trailer, err := git.ParseTrailer(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --trailer: %w", err)
+		}
+		trailers = append(trailers, trailer)
+	}
+	for _, s := range o.CoAuthors {
+		trailer, err := git.CoAuthoredBy(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --co-author: %w", err)
+		}
+		trailers = append(trailers, trailer)
+	}
+	if o.SignOff {
+		signer := o.committer()
+		if signer == nil {
+			signer = o.author()
+		}
+		if signer != nil {
+			trailers = append(trailers, git.SignedOffBy(*signer))
+		}
+	}
+	return trailers, nil
+}
+
+// resolveDates parses the dates of the author and committer.
+// If a date is not set, it falls back to SOURCE_DATE_EPOCH.
+// The environment variable is applied only if the author or committer is set,
+// because GitHub sets the current time to a commit of the login user.
+func (o *commitAttributeOptions) resolveDates(e env.Interface) error {
+	if o.AuthorName == "" && o.CommitterName == "" {
+		if e.Getenv(envSourceDateEpoch) != "" {
+			slog.Warn("Ignoring the environment variable because the author or committer is not set",
+				"variable", envSourceDateEpoch)
+		}
+		return nil
+	}
+	var err error
+	if o.AuthorDate != "" {
+		if o.authorDate, err = parseDate(o.AuthorDate); err != nil {
+			return fmt.Errorf("invalid --author-date: %w", err)
+		}
+	}
+	if o.CommitterDate != "" {
+		if o.committerDate, err = parseDate(o.CommitterDate); err != nil {
+			return fmt.Errorf("invalid --committer-date: %w", err)
+		}
+	}
+	if o.AuthorDate != "" && o.CommitterDate != "" {
+		return nil
+	}
+	sourceDateEpoch := e.Getenv(envSourceDateEpoch)
+	if sourceDateEpoch == "" {
+		return nil
+	}
+	epoch, err := strconv.ParseInt(sourceDateEpoch, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", envSourceDateEpoch, err)
+	}
+	date := time.Unix(epoch, 0).UTC()
+	slog.Debug("Using the date from environment variable", "variable", envSourceDateEpoch, "date", date)
+	if o.authorDate.IsZero() && o.AuthorName != "" {
+		o.authorDate = date
+	}
+	if o.committerDate.IsZero() {
+		o.committerDate = date
+	}
 	return nil
 }
 
+// parseDate parses a date in RFC 3339 or Unix time.
+func parseDate(s string) (time.Time, error) {
+	if epoch, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(epoch, 0).UTC(), nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("date must be RFC 3339 or Unix time: %w", err)
+	}
+	return t, nil
+}
+
 func (o *commitAttributeOptions) committer() *git.CommitAuthor {
 	if o.CommitterName != "" && o.CommitterEmail != "" {
 		return &git.CommitAuthor{
 			Name:  o.CommitterName,
 			Email: o.CommitterEmail,
+			Date:  o.committerDate,
+		}
+	}
+	if !o.committerDate.IsZero() {
+		// the committer is same as the author except the date
+		if author := o.author(); author != nil {
+			author.Date = o.committerDate
+			return author
 		}
 	}
 	return nil
@@ -48,7 +252,36 @@ func (o *commitAttributeOptions) author() *git.CommitAuthor {
 		return &git.CommitAuthor{
 			Name:  o.AuthorName,
 			Email: o.AuthorEmail,
+			Date:  o.authorDate,
 		}
 	}
 	return nil
 }
+
+// signingKey returns the key to sign a commit, or nil if not set.
+func (o *commitAttributeOptions) signingKey(e env.Interface) (*git.SigningKey, error) {
+	if o.SigningKey == "" {
+		o.SigningKey = e.Getenv(envSigningKey)
+		if o.SigningKey != "" {
+			slog.Debug("Using signing key from environment variable", "variable", envSigningKey)
+		}
+	}
+	if o.SigningKey == "" {
+		return nil, nil
+	}
+	if o.SigningFormat == "" {
+		o.SigningFormat = e.Getenv(envSigningFormat)
+	}
+	key := git.SigningKey{Format: git.SignatureFormatOpenPGP, Key: o.SigningKey}
+	switch o.SigningFormat {
+	case "", string(git.SignatureFormatOpenPGP):
+	case string(git.SignatureFormatSSH):
+		key.Format = git.SignatureFormatSSH
+	default:
+		return nil, fmt.Errorf("signing format must be openpgp or ssh but was %s", o.SigningFormat)
+	}
+	if o.author() == nil {
+		return nil, fmt.Errorf("you need to set --author-name and --author-email to sign a commit")
+	}
+	return &key, nil
+}
@@ -1,13 +1,20 @@
 package cmd
 
 import (
+	"bytes"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/int128/ghcp/mocks/github.com/int128/ghcp/pkg/fs_mock"
 	"github.com/int128/ghcp/mocks/github.com/int128/ghcp/pkg/usecases/commit_mock"
+	"github.com/int128/ghcp/pkg/fs"
 	"github.com/int128/ghcp/pkg/git"
 	"github.com/int128/ghcp/pkg/git/commitstrategy"
 	"github.com/int128/ghcp/pkg/github/client"
 	"github.com/int128/ghcp/pkg/usecases/commit"
+	"github.com/int128/ghcp/pkg/usecases/gitobject"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -22,10 +29,10 @@ func TestCmd_Run_commit(t *testing.T) {
 				CommitMessage:    "commit-message",
 				Paths:            []string{"file1", "file2"},
 			}).
-			Return(nil)
+			Return(&commit.Output{}, nil)
 		r := Runner{
 			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
-			Env:               newEnv(t, map[string]string{envGitHubAPI: ""}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""}),
 			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
 		}
 		args := []string{
@@ -54,10 +61,10 @@ func TestCmd_Run_commit(t *testing.T) {
 				CommitMessage:    "commit-message",
 				Paths:            []string{"file1", "file2"},
 			}).
-			Return(nil)
+			Return(&commit.Output{}, nil)
 		r := Runner{
 			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
-			Env:               newEnv(t, map[string]string{envGitHubAPI: ""}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""}),
 			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
 		}
 		args := []string{
@@ -88,10 +95,10 @@ func TestCmd_Run_commit(t *testing.T) {
 				CommitMessage:    "commit-message",
 				Paths:            []string{"file1", "file2"},
 			}).
-			Return(nil)
+			Return(&commit.Output{}, nil)
 		r := Runner{
 			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
-			Env:               newEnv(t, map[string]string{envGitHubAPI: ""}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""}),
 			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
 		}
 		args := []string{
@@ -123,10 +130,10 @@ func TestCmd_Run_commit(t *testing.T) {
 				CommitMessage:    "commit-message",
 				Paths:            []string{"file1", "file2"},
 			}).
-			Return(nil)
+			Return(&commit.Output{}, nil)
 		r := Runner{
 			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
-			Env:               newEnv(t, map[string]string{envGitHubAPI: ""}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""}),
 			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
 		}
 		args := []string{
@@ -147,6 +154,69 @@ func TestCmd_Run_commit(t *testing.T) {
 		}
 	})
 
+	t.Run("--force-with-lease", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(mock.Anything, commit.Input{
+				TargetRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				TargetBranchName: "topic",
+				ParentRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				CommitStrategy:   commitstrategy.RebaseOn("develop"),
+				CommitMessage:    "commit-message",
+				Paths:            []string{"file1", "file2"},
+				ForceUpdate:      true,
+				Lease:            "COMMIT_SHA",
+			}).
+			Return(&commit.Output{}, nil)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""}),
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-u", "owner",
+			"-r", "repo",
+			"-m", "commit-message",
+			"-b", "topic",
+			"--parent", "develop",
+			"--force-with-lease", "COMMIT_SHA",
+			"file1",
+			"file2",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("--force and --force-with-lease", func(t *testing.T) {
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, nil),
+			NewInternalRunner: newInternalRunner(InternalRunner{}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-u", "owner",
+			"-r", "repo",
+			"-m", "commit-message",
+			"-b", "topic",
+			"--force",
+			"--force-with-lease", "COMMIT_SHA",
+			"file1",
+			"file2",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeError {
+			t.Errorf("exitCode wants %d but %d", exitCodeError, exitCode)
+		}
+	})
+
 	t.Run("--parent and --no-parent", func(t *testing.T) {
 		r := Runner{
 			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
@@ -172,6 +242,144 @@ func TestCmd_Run_commit(t *testing.T) {
 		}
 	})
 
+	t.Run("--merge and --merge-tree", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(mock.Anything, commit.Input{
+				TargetRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				TargetBranchName: "topic",
+				ParentRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				CommitStrategy:   commitstrategy.MergeWith("release", commitstrategy.Theirs),
+				CommitMessage:    "commit-message",
+				Paths:            []string{"file1", "file2"},
+			}).
+			Return(&commit.Output{}, nil)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""}),
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-m", "commit-message",
+			"-b", "topic",
+			"--merge", "release",
+			"--merge-tree", "theirs",
+			"file1",
+			"file2",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("--merge and --parent", func(t *testing.T) {
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, nil),
+			NewInternalRunner: newInternalRunner(InternalRunner{}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-m", "commit-message",
+			"-b", "topic",
+			"--merge", "release",
+			"--parent", "develop",
+			"file1",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeError {
+			t.Errorf("exitCode wants %d but %d", exitCodeError, exitCode)
+		}
+	})
+
+	t.Run("invalid --merge-tree", func(t *testing.T) {
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, nil),
+			NewInternalRunner: newInternalRunner(InternalRunner{}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-m", "commit-message",
+			"-b", "topic",
+			"--merge", "release",
+			"--merge-tree", "both",
+			"file1",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeError {
+			t.Errorf("exitCode wants %d but %d", exitCodeError, exitCode)
+		}
+	})
+
+	t.Run("--keep-last", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(mock.Anything, commit.Input{
+				TargetRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				TargetBranchName: "gh-pages",
+				ParentRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				CommitStrategy:   commitstrategy.FastForwardKeepLast(10),
+				CommitMessage:    "commit-message",
+				Paths:            []string{"file1", "file2"},
+			}).
+			Return(&commit.Output{}, nil)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""}),
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-m", "commit-message",
+			"-b", "gh-pages",
+			"--keep-last", "10",
+			"file1",
+			"file2",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("--keep-last and --no-parent", func(t *testing.T) {
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, nil),
+			NewInternalRunner: newInternalRunner(InternalRunner{}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-m", "commit-message",
+			"-b", "gh-pages",
+			"--keep-last", "10",
+			"--no-parent",
+			"file1",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeError {
+			t.Errorf("exitCode wants %d but %d", exitCodeError, exitCode)
+		}
+	})
+
 	t.Run("only --author-name", func(t *testing.T) {
 		r := Runner{
 			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
@@ -216,31 +424,33 @@ func TestCmd_Run_commit(t *testing.T) {
 		}
 	})
 
-	t.Run("--no-file-mode", func(t *testing.T) {
+	t.Run("--file", func(t *testing.T) {
 		commitUseCase := commit_mock.NewMockInterface(t)
 		commitUseCase.EXPECT().
 			Do(mock.Anything, commit.Input{
 				TargetRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
 				ParentRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
 				CommitStrategy:   commitstrategy.FastForward,
-				CommitMessage:    "commit-message",
+				CommitMessage:    "commit-message\n\nbody",
 				Paths:            []string{"file1", "file2"},
-				NoFileMode:       true,
 			}).
-			Return(nil)
+			Return(&commit.Output{}, nil)
+		fileSystem := fs_mock.NewMockInterface(t)
+		fileSystem.EXPECT().
+			ReadFile("message.txt").
+			Return([]byte("commit-message\n\nbody\n"), nil)
 		r := Runner{
 			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
-			Env:               newEnv(t, map[string]string{envGitHubAPI: ""}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""}),
+			FileSystem:        fileSystem,
 			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
 		}
 		args := []string{
 			cmdName,
 			commitCmdName,
 			"--token", "YOUR_TOKEN",
-			"-u", "owner",
-			"-r", "repo",
-			"-m", "commit-message",
-			"--no-file-mode",
+			"-r", "owner/repo",
+			"-F", "message.txt",
 			"file1",
 			"file2",
 		}
@@ -250,31 +460,1235 @@ func TestCmd_Run_commit(t *testing.T) {
 		}
 	})
 
-	t.Run("--dry-run", func(t *testing.T) {
+	t.Run("--file=- and --message-template", func(t *testing.T) {
 		commitUseCase := commit_mock.NewMockInterface(t)
 		commitUseCase.EXPECT().
 			Do(mock.Anything, commit.Input{
 				TargetRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
 				ParentRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
 				CommitStrategy:   commitstrategy.FastForward,
-				CommitMessage:    "commit-message",
+				CommitMessage:    "Update {{ .Branch }}",
+				MessageTemplate:  true,
 				Paths:            []string{"file1", "file2"},
-				DryRun:           true,
 			}).
-			Return(nil)
+			Return(&commit.Output{}, nil)
+		fileSystem := fs_mock.NewMockInterface(t)
+		fileSystem.EXPECT().
+			Stdin().
+			Return(strings.NewReader("Update {{ .Branch }}\n"))
 		r := Runner{
 			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
-			Env:               newEnv(t, map[string]string{envGitHubAPI: ""}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""}),
+			FileSystem:        fileSystem,
 			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
 		}
 		args := []string{
 			cmdName,
 			commitCmdName,
 			"--token", "YOUR_TOKEN",
-			"-u", "owner",
-			"-r", "repo",
+			"-r", "owner/repo",
+			"-F", "-",
+			"--message-template",
+			"file1",
+			"file2",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("--message and --file", func(t *testing.T) {
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, nil),
+			NewInternalRunner: newInternalRunner(InternalRunner{}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
 			"-m", "commit-message",
-			"--dry-run",
+			"-F", "message.txt",
+			"file1",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeError {
+			t.Errorf("exitCode wants %d but %d", exitCodeError, exitCode)
+		}
+	})
+
+	t.Run("--trailer, --co-author and --signoff", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(mock.Anything, commit.Input{
+				TargetRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				ParentRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				CommitStrategy:   commitstrategy.FastForward,
+				CommitMessage: "commit-message\n\n" +
+					"Reviewed-by: Carol <carol@example.com>\n" +
+					"Co-authored-by: Bob <bob@example.com>\n" +
+					"Signed-off-by: Some Committer <committer@example.com>",
+				Committer: &git.CommitAuthor{Name: "Some Committer", Email: "committer@example.com"},
+				Paths:     []string{"file1", "file2"},
+			}).
+			Return(&commit.Output{}, nil)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, map[string]string{envSourceDateEpoch: "", envSigningKey: "", envGitHubAPI: ""}),
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-m", "commit-message",
+			"--committer-name", "Some Committer",
+			"--committer-email", "committer@example.com",
+			"--trailer", "Reviewed-by=Carol <carol@example.com>",
+			"--co-author", "Bob <bob@example.com>",
+			"--signoff",
+			"file1",
+			"file2",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("--signoff without author", func(t *testing.T) {
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, nil),
+			NewInternalRunner: newInternalRunner(InternalRunner{}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-m", "commit-message",
+			"--signoff",
+			"file1",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeError {
+			t.Errorf("exitCode wants %d but %d", exitCodeError, exitCode)
+		}
+	})
+
+	t.Run("--git-config", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(mock.Anything, commit.Input{
+				TargetRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				ParentRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				CommitStrategy:   commitstrategy.FastForward,
+				CommitMessage:    "commit-message",
+				Author:           &git.CommitAuthor{Name: "Config User", Email: "config@example.com"},
+				Paths:            []string{"file1", "file2"},
+			}).
+			Return(&commit.Output{}, nil)
+		fileSystem := fs_mock.NewMockInterface(t)
+		fileSystem.EXPECT().
+			ReadGitConfig().
+			Return(&fs.GitConfig{UserName: "Config User", UserEmail: "config@example.com"}, nil)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, map[string]string{envSourceDateEpoch: "", envSigningKey: "", envGitHubAPI: ""}),
+			FileSystem:        fileSystem,
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-m", "commit-message",
+			"--git-config",
+			"file1",
+			"file2",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("--git-config and --author-email", func(t *testing.T) {
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, nil),
+			FileSystem:        fs_mock.NewMockInterface(t),
+			NewInternalRunner: newInternalRunner(InternalRunner{}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-m", "commit-message",
+			"--git-config",
+			"--author-email", "author@example.com",
+			"file1",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeError {
+			t.Errorf("exitCode wants %d but %d", exitCodeError, exitCode)
+		}
+	})
+
+	t.Run("infer the repository and branch", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(mock.Anything, commit.Input{
+				TargetRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				TargetBranchName: "feature",
+				ParentRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				CommitStrategy:   commitstrategy.FastForward,
+				CommitMessage:    "commit-message",
+				Paths:            []string{"file1", "file2"},
+			}).
+			Return(&commit.Output{}, nil)
+		fileSystem := fs_mock.NewMockInterface(t)
+		fileSystem.EXPECT().
+			ReadGitWorkingTree().
+			Return(&fs.GitWorkingTree{
+				Remotes: map[string]string{"origin": "git@github.com:owner/repo.git"},
+				Branch:  "feature",
+			}, nil)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""}),
+			FileSystem:        fileSystem,
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-m", "commit-message",
+			"file1",
+			"file2",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("--remote of GitHub Enterprise", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(mock.Anything, commit.Input{
+				TargetRepository: git.RepositoryID{Owner: "upstream", Name: "repo"},
+				TargetBranchName: "topic",
+				ParentRepository: git.RepositoryID{Owner: "upstream", Name: "repo"},
+				CommitStrategy:   commitstrategy.FastForward,
+				CommitMessage:    "commit-message",
+				Paths:            []string{"file1", "file2"},
+			}).
+			Return(&commit.Output{}, nil)
+		fileSystem := fs_mock.NewMockInterface(t)
+		fileSystem.EXPECT().
+			ReadGitWorkingTree().
+			Return(&fs.GitWorkingTree{
+				Remotes: map[string]string{
+					"origin":   "git@github.com:owner/repo.git",
+					"upstream": "https://ghe.example.com/upstream/repo.git",
+				},
+				Branch: "feature",
+			}, nil)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN", URLv3: "https://ghe.example.com/api/v3/"}),
+			Env:               newEnv(t, map[string]string{envSigningKey: ""}),
+			FileSystem:        fileSystem,
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"--api", "https://ghe.example.com/api/v3/",
+			"--remote", "upstream",
+			"-b", "topic",
+			"-m", "commit-message",
+			"file1",
+			"file2",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("remote of another host", func(t *testing.T) {
+		fileSystem := fs_mock.NewMockInterface(t)
+		fileSystem.EXPECT().
+			ReadGitWorkingTree().
+			Return(&fs.GitWorkingTree{
+				Remotes: map[string]string{"origin": "https://ghe.example.com/owner/repo.git"},
+			}, nil)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, map[string]string{envGitHubAPI: ""}),
+			FileSystem:        fileSystem,
+			NewInternalRunner: newInternalRunner(InternalRunner{}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-m", "commit-message",
+			"file1",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeError {
+			t.Errorf("exitCode wants %d but %d", exitCodeError, exitCode)
+		}
+	})
+
+	t.Run("GitHub Actions", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(mock.Anything, commit.Input{
+				TargetRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				TargetBranchName: "topic",
+				ParentRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				CommitStrategy:   commitstrategy.FastForward,
+				CommitMessage:    "commit-message",
+				Paths:            []string{"file1", "file2"},
+			}).
+			Return(&commit.Output{}, nil)
+		r := Runner{
+			NewGitHub: newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env: newEnv(t, map[string]string{
+				envSigningKey:        "",
+				envGitHubAPI:         "",
+				envGitHubActions:     "true",
+				envGitHubRepository:  "owner/repo",
+				envGitHubAPIURL:      "https://api.github.com",
+				envGitHubOutput:      "",
+				envGitHubStepSummary: "",
+			}),
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-b", "topic",
+			"-m", "commit-message",
+			"file1",
+			"file2",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("GitHub Actions with --workflow-parent", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(mock.Anything, commit.Input{
+				TargetRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				TargetBranchName: "topic",
+				ParentRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				CommitStrategy:   commitstrategy.FastForward,
+				NewBranchParent:  "WORKFLOW_SHA",
+				CommitMessage:    "commit-message",
+				Paths:            []string{"file1", "file2"},
+			}).
+			Return(&commit.Output{}, nil)
+		r := Runner{
+			NewGitHub: newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env: newEnv(t, map[string]string{
+				envSigningKey:        "",
+				envGitHubAPI:         "",
+				envGitHubActions:     "true",
+				envGitHubRepository:  "owner/repo",
+				envGitHubSHA:         "WORKFLOW_SHA",
+				envGitHubAPIURL:      "https://api.github.com",
+				envGitHubOutput:      "",
+				envGitHubStepSummary: "",
+			}),
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-b", "topic",
+			"--workflow-parent",
+			"-m", "commit-message",
+			"file1",
+			"file2",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("--workflow-parent without GitHub Actions", func(t *testing.T) {
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, nil),
+			NewInternalRunner: newInternalRunner(InternalRunner{}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-b", "topic",
+			"--workflow-parent",
+			"-m", "commit-message",
+			"file1",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeError {
+			t.Errorf("exitCode wants %d but %d", exitCodeError, exitCode)
+		}
+	})
+
+	t.Run("--workflow-parent and --parent", func(t *testing.T) {
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, nil),
+			NewInternalRunner: newInternalRunner(InternalRunner{}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-b", "topic",
+			"--workflow-parent",
+			"--parent", "develop",
+			"-m", "commit-message",
+			"file1",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeError {
+			t.Errorf("exitCode wants %d but %d", exitCodeError, exitCode)
+		}
+	})
+
+	t.Run("GitHub Actions of GitHub Enterprise with --repo", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(mock.Anything, commit.Input{
+				TargetRepository: git.RepositoryID{Owner: "another", Name: "repo"},
+				TargetBranchName: "topic",
+				ParentRepository: git.RepositoryID{Owner: "another", Name: "repo"},
+				CommitStrategy:   commitstrategy.FastForward,
+				CommitMessage:    "commit-message",
+				Paths:            []string{"file1", "file2"},
+			}).
+			Return(&commit.Output{}, nil)
+		r := Runner{
+			NewGitHub: newGitHub(t, client.Option{Token: "YOUR_TOKEN", URLv3: "https://ghe.example.com/api/v3"}),
+			Env: newEnv(t, map[string]string{
+				envSigningKey:        "",
+				envGitHubAPI:         "",
+				envGitHubActions:     "true",
+				envGitHubAPIURL:      "https://ghe.example.com/api/v3",
+				envGitHubOutput:      "",
+				envGitHubStepSummary: "",
+			}),
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "another/repo",
+			"-b", "topic",
+			"-m", "commit-message",
+			"file1",
+			"file2",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("--plan", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Plan(mock.Anything, commit.Input{
+				TargetRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				TargetBranchName: "topic",
+				ParentRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				CommitStrategy:   commitstrategy.FastForward,
+				CommitMessage:    "commit-message",
+				Paths:            []string{"file1", "file2"},
+			}).
+			Return(&commit.PlanOutput{
+				Repository:      git.RepositoryID{Owner: "owner", Name: "repo"},
+				BranchName:      "topic",
+				ParentCommitSHA: "COMMIT_SHA",
+				Changes: gitobject.Changes{
+					Added:       []string{"file1"},
+					ModeChanged: []string{"file2"},
+				},
+			}, nil)
+		var stdout bytes.Buffer
+		mockEnv := newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""})
+		mockEnv.EXPECT().Stdout().Return(&stdout)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               mockEnv,
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-b", "topic",
+			"-m", "commit-message",
+			"--plan",
+			"file1",
+			"file2",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+		want := `Repository: owner/repo
+Branch: topic (new)
+Parent: COMMIT_SHA
+added         file1
+mode changed  file2
+`
+		if diff := cmp.Diff(want, stdout.String()); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("--plan with --keep-last", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Plan(mock.Anything, commit.Input{
+				TargetRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				TargetBranchName: "topic",
+				ParentRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				CommitStrategy:   commitstrategy.FastForwardKeepLast(10),
+				CommitMessage:    "commit-message",
+				Paths:            []string{"file1", "file2"},
+			}).
+			Return(&commit.PlanOutput{
+				Repository:      git.RepositoryID{Owner: "owner", Name: "repo"},
+				BranchName:      "topic",
+				BranchExists:    true,
+				ParentCommitSHA: "COMMIT_SHA",
+				RewriteHistory:  true,
+				ForceUpdate:     true,
+				Changes: gitobject.Changes{
+					Added:       []string{"file1"},
+					ModeChanged: []string{"file2"},
+				},
+			}, nil)
+		var stdout bytes.Buffer
+		mockEnv := newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""})
+		mockEnv.EXPECT().Stdout().Return(&stdout)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               mockEnv,
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-b", "topic",
+			"-m", "commit-message",
+			"--plan",
+			"--keep-last", "10",
+			"file1",
+			"file2",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+		want := `Repository: owner/repo
+Branch: topic (force update)
+Parent: COMMIT_SHA (history rewritten)
+added         file1
+mode changed  file2
+`
+		if diff := cmp.Diff(want, stdout.String()); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("--author-date and --committer-date", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(mock.Anything, commit.Input{
+				TargetRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				ParentRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				CommitStrategy:   commitstrategy.FastForward,
+				CommitMessage:    "commit-message",
+				Author: &git.CommitAuthor{
+					Name:  "Some Author",
+					Email: "author@example.com",
+					Date:  time.Date(2026, 1, 2, 3, 4, 5, 0, time.FixedZone("", 9*60*60)),
+				},
+				Committer: &git.CommitAuthor{
+					Name:  "Some Author",
+					Email: "author@example.com",
+					Date:  time.Unix(1700000000, 0).UTC(),
+				},
+				Paths: []string{"file1", "file2"},
+			}).
+			Return(&commit.Output{}, nil)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""}),
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-m", "commit-message",
+			"--author-name", "Some Author",
+			"--author-email", "author@example.com",
+			"--author-date", "2026-01-02T03:04:05+09:00",
+			"--committer-date", "1700000000",
+			"file1",
+			"file2",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("env/SOURCE_DATE_EPOCH", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(mock.Anything, commit.Input{
+				TargetRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				ParentRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				CommitStrategy:   commitstrategy.FastForward,
+				CommitMessage:    "commit-message",
+				Author: &git.CommitAuthor{
+					Name:  "Some Author",
+					Email: "author@example.com",
+					Date:  time.Unix(1700000000, 0).UTC(),
+				},
+				Committer: &git.CommitAuthor{
+					Name:  "Some Committer",
+					Email: "committer@example.com",
+					Date:  time.Unix(1700000000, 0).UTC(),
+				},
+				Paths: []string{"file1", "file2"},
+			}).
+			Return(&commit.Output{}, nil)
+		r := Runner{
+			NewGitHub: newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env: newEnv(t, map[string]string{
+				envSourceDateEpoch: "1700000000",
+				envSigningKey:      "",
+				envGitHubAPI:       "",
+			}),
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-m", "commit-message",
+			"--author-name", "Some Author",
+			"--author-email", "author@example.com",
+			"--committer-name", "Some Committer",
+			"--committer-email", "committer@example.com",
+			"file1",
+			"file2",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("env/SOURCE_DATE_EPOCH without author", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(mock.Anything, commit.Input{
+				TargetRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				ParentRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				CommitStrategy:   commitstrategy.FastForward,
+				CommitMessage:    "commit-message",
+				Paths:            []string{"file1", "file2"},
+			}).
+			Return(&commit.Output{}, nil)
+		r := Runner{
+			NewGitHub: newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env: newEnv(t, map[string]string{
+				envSourceDateEpoch: "1700000000",
+				envSigningKey:      "",
+				envGitHubAPI:       "",
+			}),
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-m", "commit-message",
+			"file1",
+			"file2",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("--author-date without author", func(t *testing.T) {
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, nil),
+			NewInternalRunner: newInternalRunner(InternalRunner{}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-m", "commit-message",
+			"--author-date", "1700000000",
+			"file1",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeError {
+			t.Errorf("exitCode wants %d but %d", exitCodeError, exitCode)
+		}
+	})
+
+	t.Run("--signing-key", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(mock.Anything, commit.Input{
+				TargetRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				ParentRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				CommitStrategy:   commitstrategy.FastForward,
+				CommitMessage:    "commit-message",
+				Author:           &git.CommitAuthor{Name: "Some Author", Email: "author@example.com"},
+				SigningKey:       &git.SigningKey{Format: git.SignatureFormatOpenPGP, Key: "KEY_ID"},
+				Paths:            []string{"file1", "file2"},
+			}).
+			Return(&commit.Output{}, nil)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, map[string]string{envSourceDateEpoch: "", envSigningFormat: "", envGitHubAPI: ""}),
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-m", "commit-message",
+			"--author-name", "Some Author",
+			"--author-email", "author@example.com",
+			"--signing-key", "KEY_ID",
+			"file1",
+			"file2",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("env/GHCP_SIGNING_KEY", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(mock.Anything, commit.Input{
+				TargetRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				ParentRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				CommitStrategy:   commitstrategy.FastForward,
+				CommitMessage:    "commit-message",
+				Author:           &git.CommitAuthor{Name: "Some Author", Email: "author@example.com"},
+				SigningKey:       &git.SigningKey{Format: git.SignatureFormatSSH, Key: "id_ed25519"},
+				Paths:            []string{"file1", "file2"},
+			}).
+			Return(&commit.Output{}, nil)
+		r := Runner{
+			NewGitHub: newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env: newEnv(t, map[string]string{
+				envSourceDateEpoch: "",
+				envSigningKey:      "id_ed25519",
+				envSigningFormat:   "ssh",
+				envGitHubAPI:       "",
+			}),
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-m", "commit-message",
+			"--author-name", "Some Author",
+			"--author-email", "author@example.com",
+			"file1",
+			"file2",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("--signing-key without author", func(t *testing.T) {
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, map[string]string{envSigningFormat: ""}),
+			NewInternalRunner: newInternalRunner(InternalRunner{}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-m", "commit-message",
+			"--signing-key", "KEY_ID",
+			"file1",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeError {
+			t.Errorf("exitCode wants %d but %d", exitCodeError, exitCode)
+		}
+	})
+
+	t.Run("--no-file-mode", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(mock.Anything, commit.Input{
+				TargetRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				ParentRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				CommitStrategy:   commitstrategy.FastForward,
+				CommitMessage:    "commit-message",
+				Paths:            []string{"file1", "file2"},
+				NoFileMode:       true,
+			}).
+			Return(&commit.Output{}, nil)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""}),
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-u", "owner",
+			"-r", "repo",
+			"-m", "commit-message",
+			"--no-file-mode",
+			"file1",
+			"file2",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("--dry-run", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(mock.Anything, commit.Input{
+				TargetRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				ParentRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				CommitStrategy:   commitstrategy.FastForward,
+				CommitMessage:    "commit-message",
+				Paths:            []string{"file1", "file2"},
+				DryRun:           true,
+			}).
+			Return(&commit.Output{}, nil)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""}),
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-u", "owner",
+			"-r", "repo",
+			"-m", "commit-message",
+			"--dry-run",
+			"file1",
+			"file2",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("--delete", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(mock.Anything, commit.Input{
+				TargetRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				ParentRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				CommitStrategy:   commitstrategy.FastForward,
+				CommitMessage:    "commit-message",
+				Paths:            []string{"file1"},
+				DeletePaths:      []string{"file2", "dir/file3"},
+			}).
+			Return(&commit.Output{}, nil)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""}),
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-u", "owner",
+			"-r", "repo",
+			"-m", "commit-message",
+			"--delete", "file2",
+			"--delete", "dir/file3",
+			"file1",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("--delete and --no-parent", func(t *testing.T) {
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, nil),
+			NewInternalRunner: newInternalRunner(InternalRunner{}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-u", "owner",
+			"-r", "repo",
+			"-m", "commit-message",
+			"-b", "topic",
+			"--no-parent",
+			"--delete", "file2",
+			"file1",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeError {
+			t.Errorf("exitCode wants %d but %d", exitCodeError, exitCode)
+		}
+	})
+
+	t.Run("--sync", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(mock.Anything, commit.Input{
+				TargetRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				TargetBranchName: "gh-pages",
+				ParentRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				CommitStrategy:   commitstrategy.FastForward,
+				CommitMessage:    "commit-message",
+				Paths:            []string{"docs"},
+				Sync:             true,
+			}).
+			Return(&commit.Output{}, nil)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""}),
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-m", "commit-message",
+			"-b", "gh-pages",
+			"--sync",
+			"docs",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("--dest-dir and --strip-prefix and --map", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(mock.Anything, commit.Input{
+				TargetRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				ParentRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				CommitStrategy:   commitstrategy.FastForward,
+				CommitMessage:    "commit-message",
+				Paths:            []string{"build/out", "dist"},
+				PathMapping: commit.PathMapping{
+					StripPrefix: "build/out",
+					DestDir:     "docs",
+					Rules:       []commit.PathMappingRule{{Source: "dist", Destination: "static"}},
+				},
+			}).
+			Return(&commit.Output{}, nil)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""}),
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-m", "commit-message",
+			"--strip-prefix", "build/out",
+			"--dest-dir", "docs",
+			"--map", "dist:static",
+			"build/out",
+			"dist",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("invalid --map", func(t *testing.T) {
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, nil),
+			NewInternalRunner: newInternalRunner(InternalRunner{}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-m", "commit-message",
+			"--map", "dist",
+			"dist",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeError {
+			t.Errorf("exitCode wants %d but %d", exitCodeError, exitCode)
+		}
+	})
+
+	t.Run("--parallelism", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(mock.Anything, commit.Input{
+				TargetRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				ParentRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				CommitStrategy:   commitstrategy.FastForward,
+				CommitMessage:    "commit-message",
+				Paths:            []string{"file1", "file2"},
+				Parallelism:      4,
+			}).
+			Return(&commit.Output{}, nil)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""}),
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-m", "commit-message",
+			"--parallelism", "4",
+			"file1",
+			"file2",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("--retry", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(mock.Anything, commit.Input{
+				TargetRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				ParentRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				CommitStrategy:   commitstrategy.FastForward,
+				CommitMessage:    "commit-message",
+				Paths:            []string{"file1", "file2"},
+				Retry:            3,
+			}).
+			Return(&commit.Output{}, nil)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""}),
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-m", "commit-message",
+			"--retry", "3",
+			"file1",
+			"file2",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("--graphql", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(mock.Anything, commit.Input{
+				TargetRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				ParentRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				CommitStrategy:   commitstrategy.FastForward,
+				CommitMessage:    "commit-message",
+				Paths:            []string{"file1", "file2"},
+				GraphQL:          true,
+			}).
+			Return(&commit.Output{}, nil)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""}),
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-m", "commit-message",
+			"--graphql",
+			"file1",
+			"file2",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("--lfs", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(mock.Anything, commit.Input{
+				TargetRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				ParentRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				CommitStrategy:   commitstrategy.FastForward,
+				CommitMessage:    "commit-message",
+				Paths:            []string{"file1", "file2"},
+				LFS:              true,
+			}).
+			Return(&commit.Output{}, nil)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""}),
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-m", "commit-message",
+			"--lfs",
+			"file1",
+			"file2",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("--gitignore", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(mock.Anything, commit.Input{
+				TargetRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				ParentRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				CommitStrategy:   commitstrategy.FastForward,
+				CommitMessage:    "commit-message",
+				Paths:            []string{"file1", "file2"},
+				GitIgnore:        true,
+			}).
+			Return(&commit.Output{}, nil)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""}),
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-m", "commit-message",
+			"--gitignore",
+			"file1",
+			"file2",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("--include and --exclude", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(mock.Anything, commit.Input{
+				TargetRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				ParentRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				CommitStrategy:   commitstrategy.FastForward,
+				CommitMessage:    "commit-message",
+				Paths:            []string{"file1", "file2"},
+				Include:          []string{"*.txt"},
+				Exclude:          []string{"tmp"},
+			}).
+			Return(&commit.Output{}, nil)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""}),
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			commitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-m", "commit-message",
+			"--include", "*.txt",
+			"--exclude", "tmp",
 			"file1",
 			"file2",
 		}
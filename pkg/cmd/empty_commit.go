@@ -25,7 +25,15 @@ const emptyCommitCmdExample = `  To create an empty commit to the default branch
   To create an empty commit to a new branch from the parent branch:
     ghcp empty-commit -r OWNER/REPO -b BRANCH --parent PARENT -m MESSAGE
 
-  If the branch exists, it will fail.`
+  If the branch exists, it will fail unless --force or --force-with-lease is set.
+
+  To create a merge commit of the branch and the ref, e.g. a back-merge of the release branch:
+    ghcp empty-commit -r OWNER/REPO -b BRANCH --merge REF -m MESSAGE
+
+  To rebase the existing branch on the parent branch, only if the branch points to the commit:
+    ghcp empty-commit -r OWNER/REPO -b BRANCH --parent PARENT --force-with-lease SHA -m MESSAGE
+
+  If another commit has been pushed to the branch after ghcp read it, the force update will fail.`
 
 func (r *Runner) newEmptyCommitCmd(ctx context.Context, gOpts *globalOptions) *cobra.Command {
 	var o emptyCommitOptions
@@ -36,14 +44,42 @@ func (r *Runner) newEmptyCommitCmd(ctx context.Context, gOpts *globalOptions) *c
 		Example: emptyCommitCmdExample,
 		Args:    cobra.NoArgs,
 		RunE: func(*cobra.Command, []string) error {
+			if err := o.readGitConfig(r.FileSystem); err != nil {
+				return err
+			}
 			if err := o.validate(); err != nil {
 				return fmt.Errorf("invalid flag: %w", err)
 			}
+			defaults, err := r.inferRepository(&o.repositoryOptions, gOpts)
+			if err != nil {
+				return fmt.Errorf("invalid flag: %w", err)
+			}
+			if o.BranchName == "" {
+				o.BranchName = string(defaults.Branch)
+			}
+			var newBranchParent git.CommitSHA
+			if o.WorkflowParent {
+				if newBranchParent, err = r.workflowCommit(); err != nil {
+					return fmt.Errorf("invalid flag: %w", err)
+				}
+			}
 			targetRepository, err := o.repositoryID()
 			if err != nil {
 				return fmt.Errorf("invalid flag: %w", err)
 			}
 
+			commitMessage, err := o.commitMessage(r.FileSystem)
+			if err != nil {
+				return fmt.Errorf("invalid flag: %w", err)
+			}
+			if err := o.resolveDates(r.Env); err != nil {
+				return fmt.Errorf("invalid flag: %w", err)
+			}
+			signingKey, err := o.signingKey(r.Env)
+			if err != nil {
+				return fmt.Errorf("invalid flag: %w", err)
+			}
+
 			ir, err := r.newInternalRunner(gOpts)
 			if err != nil {
 				return fmt.Errorf("error while bootstrap of the dependencies: %w", err)
@@ -53,16 +89,24 @@ func (r *Runner) newEmptyCommitCmd(ctx context.Context, gOpts *globalOptions) *c
 				TargetBranchName: git.BranchName(o.BranchName),
 				ParentRepository: targetRepository,
 				CommitStrategy:   o.commitStrategy(),
-				CommitMessage:    git.CommitMessage(o.CommitMessage),
+				NewBranchParent:  newBranchParent,
+				CommitMessage:    commitMessage,
+				MessageTemplate:  o.MessageTemplate,
 				Author:           o.author(),
 				Committer:        o.committer(),
+				SigningKey:       signingKey,
+				ForceUpdate:      o.Force || o.ForceWithLease != "",
+				Lease:            git.CommitSHA(o.ForceWithLease),
+				Retry:            o.Retry,
+				GraphQL:          o.GraphQL,
 				DryRun:           o.DryRun,
 			}
-			if err := ir.CommitUseCase.Do(ctx, in); err != nil {
+			out, err := ir.CommitUseCase.Do(ctx, in)
+			if err != nil {
 				slog.Debug("Stacktrace", "stacktrace", err)
 				return fmt.Errorf("could not create an empty commit: %s", err)
 			}
-			return nil
+			return r.writeResult(gOpts, emptyCommitCmdName, newCommitResult(out))
 		},
 	}
 	o.register(c.Flags())
@@ -73,12 +117,34 @@ type emptyCommitOptions struct {
 	commitAttributeOptions
 	repositoryOptions
 
-	BranchName string
-	ParentRef  string
-	DryRun     bool
+	BranchName     string
+	ParentRef      string
+	WorkflowParent bool
+	MergeRef       string
+	MergeTree      string
+	Force          bool
+	ForceWithLease string
+	Retry          int
+	GraphQL        bool
+	DryRun         bool
 }
 
 func (o emptyCommitOptions) validate() error {
+	if o.MergeRef != "" && o.ParentRef != "" {
+		return fmt.Errorf("do not set both --merge and --parent")
+	}
+	if o.WorkflowParent && (o.ParentRef != "" || o.MergeRef != "") {
+		return fmt.Errorf("do not set both --workflow-parent and --parent or --merge")
+	}
+	if err := validateMergeTree(o.MergeRef, o.MergeTree); err != nil {
+		return err
+	}
+	if o.Force && o.ForceWithLease != "" {
+		return fmt.Errorf("do not set both --force and --force-with-lease")
+	}
+	if o.Retry < 0 {
+		return fmt.Errorf("--retry must be positive")
+	}
 	if err := o.commitAttributeOptions.validate(); err != nil {
 		return fmt.Errorf("%w", err)
 	}
@@ -89,13 +155,23 @@ func (o emptyCommitOptions) commitStrategy() commitstrategy.CommitStrategy {
 	if o.ParentRef != "" {
 		return commitstrategy.RebaseOn(git.RefName(o.ParentRef))
 	}
+	if o.MergeRef != "" {
+		return mergeStrategy(o.MergeRef, o.MergeTree)
+	}
 	return commitstrategy.FastForward
 }
 
 func (o *emptyCommitOptions) register(f *pflag.FlagSet) {
 	o.repositoryOptions.register(f)
-	f.StringVarP(&o.BranchName, "branch", "b", "", "Name of the branch to create or update (default: the default branch of repository)")
+	f.StringVarP(&o.BranchName, "branch", "b", "", "Name of the branch to create or update (default: the current branch if the repository is inferred from the git remote, otherwise the default branch of repository)")
 	f.StringVar(&o.ParentRef, "parent", "", "Create a commit from the parent branch/tag (default: fast-forward)")
+	f.BoolVar(&o.WorkflowParent, "workflow-parent", false, fmt.Sprintf("Create a new branch from the commit which triggered the workflow ($%s) instead of the default branch. An existing branch is updated by fast-forward", envGitHubSHA))
+	f.StringVar(&o.MergeRef, "merge", "", "Create a merge commit of the branch and the branch/tag")
+	f.StringVar(&o.MergeTree, "merge-tree", "", "Tree of the merge commit, ours or theirs (default: ours)")
+	f.BoolVar(&o.Force, "force", false, "Update the branch even if it is not fast-forward, only if it has not moved since ghcp read it")
+	f.StringVar(&o.ForceWithLease, "force-with-lease", "", "Update the branch even if it is not fast-forward, only if it points to the commit SHA")
+	f.IntVar(&o.Retry, "retry", 0, "Number of retries when another commit has been pushed to the branch")
+	f.BoolVar(&o.GraphQL, "graphql", false, "Create a commit by the GraphQL createCommitOnBranch mutation, which is verified for a GitHub App")
 	f.BoolVar(&o.DryRun, "dry-run", false, "Do not update the branch actually")
 	o.commitAttributeOptions.register(f)
 }
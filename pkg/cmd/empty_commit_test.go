@@ -21,10 +21,10 @@ func TestCmd_Run_empty_commit(t *testing.T) {
 				CommitStrategy:   commitstrategy.FastForward,
 				CommitMessage:    "commit-message",
 			}).
-			Return(nil)
+			Return(&commit.Output{}, nil)
 		r := Runner{
 			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
-			Env:               newEnv(t, map[string]string{envGitHubAPI: ""}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""}),
 			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
 		}
 		args := []string{
@@ -39,4 +39,68 @@ func TestCmd_Run_empty_commit(t *testing.T) {
 			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
 		}
 	})
+
+	t.Run("--force", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(mock.Anything, commit.Input{
+				TargetRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				TargetBranchName: "topic",
+				ParentRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				CommitStrategy:   commitstrategy.RebaseOn("develop"),
+				CommitMessage:    "commit-message",
+				ForceUpdate:      true,
+			}).
+			Return(&commit.Output{}, nil)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""}),
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			emptyCommitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-b", "topic",
+			"--parent", "develop",
+			"-m", "commit-message",
+			"--force",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("--merge", func(t *testing.T) {
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(mock.Anything, commit.Input{
+				TargetRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				TargetBranchName: "main",
+				ParentRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				CommitStrategy:   commitstrategy.MergeWith("release", commitstrategy.Ours),
+				CommitMessage:    "commit-message",
+			}).
+			Return(&commit.Output{}, nil)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""}),
+			NewInternalRunner: newInternalRunner(InternalRunner{CommitUseCase: commitUseCase}),
+		}
+		args := []string{
+			cmdName,
+			emptyCommitCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-b", "main",
+			"--merge", "release",
+			"-m", "commit-message",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
 }
@@ -21,6 +21,9 @@ func (r *Runner) newForkCommitCmd(ctx context.Context, gOpts *globalOptions) *co
 		Short: "Fork the repository and commit files to a branch",
 		Long:  `This forks the repository and commits the files to a new branch.`,
 		RunE: func(_ *cobra.Command, args []string) error {
+			if err := o.readGitConfig(r.FileSystem); err != nil {
+				return err
+			}
 			if err := o.validate(); err != nil {
 				return fmt.Errorf("invalid flag: %w", err)
 			}
@@ -29,6 +32,18 @@ func (r *Runner) newForkCommitCmd(ctx context.Context, gOpts *globalOptions) *co
 				return fmt.Errorf("invalid flag: %w", err)
 			}
 
+			commitMessage, err := o.commitMessage(r.FileSystem)
+			if err != nil {
+				return fmt.Errorf("invalid flag: %w", err)
+			}
+			if err := o.resolveDates(r.Env); err != nil {
+				return fmt.Errorf("invalid flag: %w", err)
+			}
+			signingKey, err := o.signingKey(r.Env)
+			if err != nil {
+				return fmt.Errorf("invalid flag: %w", err)
+			}
+
 			ir, err := r.newInternalRunner(gOpts)
 			if err != nil {
 				return fmt.Errorf("error while bootstrap of the dependencies: %w", err)
@@ -37,18 +52,26 @@ func (r *Runner) newForkCommitCmd(ctx context.Context, gOpts *globalOptions) *co
 				ParentRepository: upstreamRepository,
 				TargetBranchName: git.BranchName(o.TargetBranchName),
 				CommitStrategy:   o.commitStrategy(),
-				CommitMessage:    git.CommitMessage(o.CommitMessage),
+				CommitMessage:    commitMessage,
+				MessageTemplate:  o.MessageTemplate,
 				Author:           o.author(),
 				Committer:        o.committer(),
+				SigningKey:       signingKey,
 				Paths:            args,
 				NoFileMode:       o.NoFileMode,
+				Parallelism:      o.Parallelism,
+				LFS:              o.LFS,
+				GitIgnore:        o.GitIgnore,
+				Include:          o.Include,
+				Exclude:          o.Exclude,
 				DryRun:           o.DryRun,
 			}
-			if err := ir.ForkCommitUseCase.Do(ctx, in); err != nil {
+			out, err := ir.ForkCommitUseCase.Do(ctx, in)
+			if err != nil {
 				slog.Debug("Stacktrace", "stacktrace", err)
 				return fmt.Errorf("could not commit the files: %s", err)
 			}
-			return nil
+			return r.writeResult(gOpts, forkCommitCmdName, newCommitResult(out))
 		},
 	}
 	o.register(c.Flags())
@@ -62,6 +85,11 @@ type forkCommitOptions struct {
 	UpstreamBranchName string
 	TargetBranchName   string
 	NoFileMode         bool
+	Parallelism        int
+	LFS                bool
+	GitIgnore          bool
+	Include            []string
+	Exclude            []string
 	DryRun             bool
 }
 
@@ -69,6 +97,9 @@ func (o forkCommitOptions) validate() error {
 	if o.TargetBranchName == "" {
 		return errors.New("--branch is missing")
 	}
+	if o.Parallelism < 0 {
+		return errors.New("--parallelism must be positive")
+	}
 	if err := o.commitAttributeOptions.validate(); err != nil {
 		return fmt.Errorf("%w", err)
 	}
@@ -88,6 +119,11 @@ func (o *forkCommitOptions) register(f *pflag.FlagSet) {
 	f.StringVar(&o.UpstreamBranchName, "parent", "", "Upstream branch name (default: the default branch of the upstream repository)")
 	f.StringVarP(&o.TargetBranchName, "branch", "b", "", "Name of the branch to create (mandatory)")
 	f.BoolVar(&o.NoFileMode, "no-file-mode", false, "Ignore executable bit of file and treat as 0644")
+	f.IntVar(&o.Parallelism, "parallelism", 0, "Number of files to upload concurrently (default: 1)")
+	f.BoolVar(&o.LFS, "lfs", false, "Upload files matched to filter=lfs in .gitattributes to Git LFS")
+	f.BoolVar(&o.GitIgnore, "gitignore", false, "Exclude files ignored by .gitignore and .git/info/exclude")
+	f.StringArrayVar(&o.Include, "include", nil, "Glob pattern of the files to include, e.g. dist/**/*.tar.gz (multiple)")
+	f.StringArrayVar(&o.Exclude, "exclude", nil, "Glob pattern of the files or directories to exclude (multiple)")
 	f.BoolVar(&o.DryRun, "dry-run", false, "Upload files but do not update the branch actually")
 	o.commitAttributeOptions.register(f)
 }
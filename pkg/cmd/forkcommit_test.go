@@ -7,6 +7,7 @@ import (
 	"github.com/int128/ghcp/pkg/git"
 	"github.com/int128/ghcp/pkg/git/commitstrategy"
 	"github.com/int128/ghcp/pkg/github/client"
+	"github.com/int128/ghcp/pkg/usecases/commit"
 	"github.com/int128/ghcp/pkg/usecases/forkcommit"
 	"github.com/stretchr/testify/mock"
 )
@@ -22,10 +23,10 @@ func TestCmd_Run_forkcommit(t *testing.T) {
 				CommitMessage:    "commit-message",
 				Paths:            []string{"file1", "file2"},
 			}).
-			Return(nil)
+			Return(&commit.Output{}, nil)
 		r := Runner{
 			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
-			Env:               newEnv(t, map[string]string{envGitHubAPI: ""}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""}),
 			NewInternalRunner: newInternalRunner(InternalRunner{ForkCommitUseCase: commitUseCase}),
 		}
 		args := []string{
@@ -54,10 +55,10 @@ func TestCmd_Run_forkcommit(t *testing.T) {
 				CommitMessage:    "commit-message",
 				Paths:            []string{"file1", "file2"},
 			}).
-			Return(nil)
+			Return(&commit.Output{}, nil)
 		r := Runner{
 			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
-			Env:               newEnv(t, map[string]string{envGitHubAPI: ""}),
+			Env:               newEnv(t, map[string]string{envSigningKey: "", envGitHubAPI: ""}),
 			NewInternalRunner: newInternalRunner(InternalRunner{ForkCommitUseCase: commitUseCase}),
 		}
 		args := []string{
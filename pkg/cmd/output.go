@@ -0,0 +1,259 @@
+package cmd
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+
+	"github.com/int128/ghcp/pkg/usecases/commit"
+	"github.com/int128/ghcp/pkg/usecases/pullrequest"
+	"github.com/int128/ghcp/pkg/usecases/release"
+)
+
+const (
+	outputJSON = "json"
+
+	envGitHubOutput      = "GITHUB_OUTPUT"
+	envGitHubStepSummary = "GITHUB_STEP_SUMMARY"
+)
+
+// result represents the result of a command.
+// It is written to standard output by --output and to the outputs of GitHub Actions.
+type result []resultField
+
+type resultField struct {
+	Key   string
+	Value any
+}
+
+// MarshalJSON returns an object of the fields in order.
+func (res result) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, field := range res {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		key, err := json.Marshal(field.Key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(field.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of %s: %w", field.Key, err)
+		}
+		b.Write(key)
+		b.WriteByte(':')
+		b.Write(value)
+	}
+	b.WriteByte('}')
+	return b.Bytes(), nil
+}
+
+// outputs returns the lines of key=value for $GITHUB_OUTPUT.
+// A value other than string is encoded as JSON, which can be parsed by fromJSON() in a workflow.
+func (res result) outputs() (string, error) {
+	var b strings.Builder
+	for _, field := range res {
+		value, err := field.stringValue()
+		if err != nil {
+			return "", err
+		}
+		if strings.Contains(value, "\n") {
+			delimiter, err := newOutputDelimiter()
+			if err != nil {
+				return "", err
+			}
+			if strings.Contains(value, delimiter) {
+				return "", fmt.Errorf("value of %s contains the delimiter", field.Key)
+			}
+			fmt.Fprintf(&b, "%s<<%s\n%s\n%s\n", field.Key, delimiter, value, delimiter)
+			continue
+		}
+		fmt.Fprintf(&b, "%s=%s\n", field.Key, value)
+	}
+	return b.String(), nil
+}
+
+// newOutputDelimiter returns a random delimiter of a multiline value,
+// so that a value cannot inject another output by containing the delimiter.
+func newOutputDelimiter() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("could not generate the delimiter of output: %w", err)
+	}
+	return "ghadelimiter_" + hex.EncodeToString(b), nil
+}
+
+// summary returns a Markdown table for $GITHUB_STEP_SUMMARY.
+func (res result) summary(title string) (string, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "### %s\n\n", title)
+	b.WriteString("| Key | Value |\n")
+	b.WriteString("| --- | --- |\n")
+	for _, field := range res {
+		value, err := field.stringValue()
+		if err != nil {
+			return "", err
+		}
+		value = strings.ReplaceAll(value, "|", `\|`)
+		value = strings.ReplaceAll(value, "\n", "<br>")
+		fmt.Fprintf(&b, "| %s | %s |\n", field.Key, value)
+	}
+	b.WriteString("\n")
+	return b.String(), nil
+}
+
+func (field resultField) stringValue() (string, error) {
+	if s, ok := field.Value.(string); ok {
+		return s, nil
+	}
+	b, err := json.Marshal(field.Value)
+	if err != nil {
+		return "", fmt.Errorf("invalid value of %s: %w", field.Key, err)
+	}
+	return string(b), nil
+}
+
+// writeResult writes the result to standard output if --output is set,
+// and to the outputs and step summary if running on GitHub Actions.
+func (r *Runner) writeResult(gOpts *globalOptions, cmdName string, res result) error {
+	if gOpts.Output == outputJSON {
+		e := json.NewEncoder(r.Env.Stdout())
+		e.SetIndent("", "  ")
+		if err := e.Encode(res); err != nil {
+			return fmt.Errorf("could not write the result: %w", err)
+		}
+	}
+	if !r.inGitHubActions() {
+		return nil
+	}
+	if name := r.Env.Getenv(envGitHubOutput); name != "" {
+		outputs, err := res.outputs()
+		if err != nil {
+			return fmt.Errorf("could not write the outputs: %w", err)
+		}
+		if err := r.Env.AppendFile(name, []byte(outputs)); err != nil {
+			return fmt.Errorf("could not write the outputs: %w", err)
+		}
+		slog.Debug("Wrote the result to the outputs of GitHub Actions", "variable", envGitHubOutput)
+	}
+	if name := r.Env.Getenv(envGitHubStepSummary); name != "" {
+		summary, err := res.summary(fmt.Sprintf("ghcp %s", cmdName))
+		if err != nil {
+			return fmt.Errorf("could not write the step summary: %w", err)
+		}
+		if err := r.Env.AppendFile(name, []byte(summary)); err != nil {
+			return fmt.Errorf("could not write the step summary: %w", err)
+		}
+		slog.Debug("Wrote the result to the step summary of GitHub Actions", "variable", envGitHubStepSummary)
+	}
+	return nil
+}
+
+// writePlan writes the changes in text to standard output, or the result if --output is set.
+func (r *Runner) writePlan(gOpts *globalOptions, out *commit.PlanOutput) error {
+	if gOpts.Output == "" {
+		if err := writePlanText(r.Env.Stdout(), out); err != nil {
+			return fmt.Errorf("could not write the plan: %w", err)
+		}
+	}
+	return r.writeResult(gOpts, commitCmdName, newPlanResult(out))
+}
+
+func writePlanText(w io.Writer, out *commit.PlanOutput) error {
+	var b strings.Builder
+	branch := string(out.BranchName)
+	if !out.BranchExists {
+		branch += " (new)"
+	}
+	if out.ForceUpdate {
+		branch += " (force update)"
+	}
+	parent := string(out.ParentCommitSHA)
+	if parent == "" {
+		parent = "(none)"
+	}
+	if out.RewriteHistory {
+		parent += " (history rewritten)"
+	}
+	fmt.Fprintf(&b, "Repository: %s\nBranch: %s\nParent: %s\n", out.Repository, branch, parent)
+	if out.Changes.IsEmpty() {
+		b.WriteString("Nothing to commit\n")
+	}
+	for _, change := range []struct {
+		name      string
+		filenames []string
+	}{
+		{"added", out.Changes.Added},
+		{"modified", out.Changes.Modified},
+		{"mode changed", out.Changes.ModeChanged},
+		{"deleted", out.Changes.Deleted},
+	} {
+		for _, filename := range change.filenames {
+			fmt.Fprintf(&b, "%-14s%s\n", change.name, filename)
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func newPlanResult(out *commit.PlanOutput) result {
+	return result{
+		{"repository", out.Repository.String()},
+		{"branch", string(out.BranchName)},
+		{"branch_exists", out.BranchExists},
+		{"parent_commit_sha", string(out.ParentCommitSHA)},
+		{"rewrite_history", out.RewriteHistory},
+		{"force_update", out.ForceUpdate},
+		{"added", nonNil(out.Changes.Added)},
+		{"modified", nonNil(out.Changes.Modified)},
+		{"mode_changed", nonNil(out.Changes.ModeChanged)},
+		{"deleted", nonNil(out.Changes.Deleted)},
+	}
+}
+
+// nonNil returns an empty slice if nil, which is encoded as [] instead of null.
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
+func newCommitResult(out *commit.Output) result {
+	return result{
+		{"repository", out.Repository.String()},
+		{"branch", string(out.BranchName)},
+		{"commit_sha", string(out.CommitSHA)},
+		{"changed_files", out.ChangedFiles},
+		{"deleted_files", out.DeletedFiles},
+		{"branch_created", out.BranchCreated},
+	}
+}
+
+func newPullRequestResult(out *pullrequest.Output) result {
+	return result{
+		{"pull_request_url", out.URL},
+		{"pull_request_created", out.Created},
+	}
+}
+
+func newReleaseResult(in release.Input, out *release.Output) result {
+	var releaseID int64
+	if out.Release != nil {
+		releaseID = out.Release.ID.InternalID
+	}
+	return result{
+		{"repository", in.Repository.String()},
+		{"tag", in.TagName.Name()},
+		{"release_id", releaseID},
+		{"release_created", out.Created},
+		{"uploaded_assets", nonNil(out.UploadedAssets)},
+	}
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestResult_outputs(t *testing.T) {
+	t.Run("single line", func(t *testing.T) {
+		res := result{{Key: "repository", Value: "owner/repo"}, {Key: "changed_files", Value: 2}}
+		got, err := res.outputs()
+		if err != nil {
+			t.Fatalf("err wants nil but %+v", err)
+		}
+		want := "repository=owner/repo\nchanged_files=2\n"
+		if got != want {
+			t.Errorf("outputs wants %q but %q", want, got)
+		}
+	})
+
+	t.Run("multiline", func(t *testing.T) {
+		// a value cannot inject another output by a fixed delimiter
+		res := result{{Key: "message", Value: "line1\nGHCP_OUTPUT_EOF\ninjected=true"}}
+		pattern := regexp.MustCompile(`^message<<(ghadelimiter_[0-9a-f]{32})\nline1\nGHCP_OUTPUT_EOF\ninjected=true\n(ghadelimiter_[0-9a-f]{32})\n$`)
+		var delimiters []string
+		for range 2 {
+			got, err := res.outputs()
+			if err != nil {
+				t.Fatalf("err wants nil but %+v", err)
+			}
+			m := pattern.FindStringSubmatch(got)
+			if m == nil {
+				t.Fatalf("outputs wants %s but %q", pattern, got)
+			}
+			if m[1] != m[2] {
+				t.Errorf("delimiters wants same but %s and %s", m[1], m[2])
+			}
+			delimiters = append(delimiters, m[1])
+		}
+		if delimiters[0] == delimiters[1] {
+			t.Errorf("delimiter wants random for each write but %s", delimiters[0])
+		}
+	})
+}
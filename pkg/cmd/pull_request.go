@@ -15,6 +15,9 @@ import (
 const pullRequestCmdExample = ` To create a pull request from the feature branch to the default branch:
     ghcp pull-request -r OWNER/REPO -b feature --title TITLE --body BODY
 
+  To create a pull request from the current branch of the local checkout to the default branch:
+    ghcp pull-request --title TITLE --body BODY
+
   To create a pull request from the feature branch to the develop branch:
     ghcp pull-request -r OWNER/REPO -b feature --base develop --title TITLE --body BODY
 
@@ -36,6 +39,16 @@ func (r *Runner) newPullRequestCmd(ctx context.Context, gOpts *globalOptions) *c
 			if err := o.validate(); err != nil {
 				return fmt.Errorf("invalid flag: %w", err)
 			}
+			defaults, err := r.inferRepository(&o.Head, gOpts)
+			if err != nil {
+				return fmt.Errorf("invalid flag: %w", err)
+			}
+			if o.HeadBranchName == "" {
+				o.HeadBranchName = string(defaults.Branch)
+			}
+			if o.HeadBranchName == "" {
+				return fmt.Errorf("invalid flag: you need to set -b")
+			}
 			headRepository, err := o.Head.repositoryID()
 			if err != nil {
 				return fmt.Errorf("invalid flag: %w", err)
@@ -63,11 +76,12 @@ func (r *Runner) newPullRequestCmd(ctx context.Context, gOpts *globalOptions) *c
 				Reviewer:       o.Reviewer,
 				Draft:          o.Draft,
 			}
-			if err := ir.PullRequestUseCase.Do(ctx, in); err != nil {
+			out, err := ir.PullRequestUseCase.Do(ctx, in)
+			if err != nil {
 				slog.Debug("Stacktrace", "stacktrace", err)
 				return fmt.Errorf("could not create a pull request: %s", err)
 			}
-			return nil
+			return r.writeResult(gOpts, pullRequestCmdName, newPullRequestResult(out))
 		},
 	}
 	o.register(c.Flags())
@@ -87,16 +101,17 @@ type pullRequestOptions struct {
 }
 
 func (o pullRequestOptions) validate() error {
-	if o.HeadBranchName == "" || o.Title == "" {
-		return errors.New("you need to set -b and --title")
+	if o.Title == "" {
+		return errors.New("you need to set --title")
 	}
 	return nil
 }
 
 func (o *pullRequestOptions) register(f *pflag.FlagSet) {
-	f.StringVarP(&o.Head.RepositoryName, "head-repo", "r", "", "Head repository name, either -r OWNER/REPO or -u OWNER -r REPO (mandatory)")
+	f.StringVarP(&o.Head.RepositoryName, "head-repo", "r", "", fmt.Sprintf("Head repository name, either -r OWNER/REPO or -u OWNER -r REPO (default: $%s on GitHub Actions or the git remote)", envGitHubRepository))
 	f.StringVarP(&o.Head.RepositoryOwner, "head-owner", "u", "", "Head repository owner")
-	f.StringVarP(&o.HeadBranchName, "head", "b", "", "Head branch name (mandatory)")
+	f.StringVar(&o.Head.Remote, "remote", "", fmt.Sprintf("Name of the git remote to infer the head repository if -r is omitted (default: %s)", defaultRemoteName))
+	f.StringVarP(&o.HeadBranchName, "head", "b", "", "Head branch name (default: the current branch of the local checkout)")
 	f.StringVar(&o.Base.RepositoryName, "base-repo", "", "Base repository name, either --base-repo OWNER/REPO or --base-owner OWNER --base-repo REPO (default: head)")
 	f.StringVar(&o.Base.RepositoryOwner, "base-owner", "", "Base repository owner (default: head)")
 	f.StringVar(&o.BaseBranchName, "base", "", "Base branch name (default: default branch of base repository)")
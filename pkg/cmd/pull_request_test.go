@@ -3,7 +3,9 @@ package cmd
 import (
 	"testing"
 
+	"github.com/int128/ghcp/mocks/github.com/int128/ghcp/pkg/fs_mock"
 	"github.com/int128/ghcp/mocks/github.com/int128/ghcp/pkg/usecases/pullrequest_mock"
+	"github.com/int128/ghcp/pkg/fs"
 	"github.com/int128/ghcp/pkg/git"
 	"github.com/int128/ghcp/pkg/github/client"
 	"github.com/int128/ghcp/pkg/usecases/pullrequest"
@@ -20,7 +22,7 @@ func TestCmd_Run_pull_request(t *testing.T) {
 				BaseRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
 				Title:          "commit-message",
 			}).
-			Return(nil)
+			Return(&pullrequest.Output{}, nil)
 		r := Runner{
 			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
 			Env:               newEnv(t, map[string]string{envGitHubAPI: ""}),
@@ -49,7 +51,7 @@ func TestCmd_Run_pull_request(t *testing.T) {
 				BaseRepository: git.RepositoryID{Owner: "upstream-owner", Name: "upstream-repo"},
 				Title:          "commit-message",
 			}).
-			Return(nil)
+			Return(&pullrequest.Output{}, nil)
 		r := Runner{
 			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
 			Env:               newEnv(t, map[string]string{envGitHubAPI: ""}),
@@ -83,7 +85,7 @@ func TestCmd_Run_pull_request(t *testing.T) {
 				Reviewer:       "the-reviewer",
 				Draft:          true,
 			}).
-			Return(nil)
+			Return(&pullrequest.Output{}, nil)
 		r := Runner{
 			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
 			Env:               newEnv(t, map[string]string{envGitHubAPI: ""}),
@@ -109,4 +111,60 @@ func TestCmd_Run_pull_request(t *testing.T) {
 			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
 		}
 	})
+
+	t.Run("infer the head repository and branch", func(t *testing.T) {
+		useCase := pullrequest_mock.NewMockInterface(t)
+		useCase.EXPECT().
+			Do(mock.Anything, pullrequest.Input{
+				HeadRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				HeadBranchName: "feature",
+				BaseRepository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				Title:          "commit-message",
+			}).
+			Return(&pullrequest.Output{}, nil)
+		fileSystem := fs_mock.NewMockInterface(t)
+		fileSystem.EXPECT().
+			ReadGitWorkingTree().
+			Return(&fs.GitWorkingTree{
+				Remotes: map[string]string{"origin": "git@github.com:owner/repo.git"},
+				Branch:  "feature",
+			}, nil)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, map[string]string{envGitHubAPI: ""}),
+			FileSystem:        fileSystem,
+			NewInternalRunner: newInternalRunner(InternalRunner{PullRequestUseCase: useCase}),
+		}
+		args := []string{
+			cmdName,
+			pullRequestCmdName,
+			"--token", "YOUR_TOKEN",
+			"--title", "commit-message",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("GitHub Actions without -b", func(t *testing.T) {
+		r := Runner{
+			NewGitHub: newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env: newEnv(t, map[string]string{
+				envGitHubActions:    "true",
+				envGitHubRepository: "owner/repo",
+			}),
+			NewInternalRunner: newInternalRunner(InternalRunner{}),
+		}
+		args := []string{
+			cmdName,
+			pullRequestCmdName,
+			"--token", "YOUR_TOKEN",
+			"--title", "commit-message",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeError {
+			t.Errorf("exitCode wants %d but %d", exitCodeError, exitCode)
+		}
+	})
 }
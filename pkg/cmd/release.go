@@ -23,6 +23,9 @@ const releaseCmdExample = `  To upload files to the release associated to tag TA
 
   If the tag already exists, it ignores the target commit.
   If the release already exist, it only uploads the files.
+
+  To upload the archives in dist directory except SBOM:
+    ghcp release -r OWNER/REPO -t TAG --include 'dist/**/*.tar.gz' --exclude '**/*.sbom.tar.gz' dist
 `
 
 func (r *Runner) newReleaseCmd(ctx context.Context, gOpts *globalOptions) *cobra.Command {
@@ -36,6 +39,9 @@ func (r *Runner) newReleaseCmd(ctx context.Context, gOpts *globalOptions) *cobra
 			if err := o.validate(); err != nil {
 				return fmt.Errorf("invalid flag: %w", err)
 			}
+			if _, err := r.inferRepository(&o.repositoryOptions, gOpts); err != nil {
+				return fmt.Errorf("invalid flag: %w", err)
+			}
 			targetRepository, err := o.repositoryID()
 			if err != nil {
 				return fmt.Errorf("invalid flag: %w", err)
@@ -50,13 +56,16 @@ func (r *Runner) newReleaseCmd(ctx context.Context, gOpts *globalOptions) *cobra
 				TagName:                 git.TagName(o.TagName),
 				TargetBranchOrCommitSHA: o.TargetBranchOrCommitSHA,
 				Paths:                   args,
+				Include:                 o.Include,
+				Exclude:                 o.Exclude,
 				DryRun:                  o.DryRun,
 			}
-			if err := ir.ReleaseUseCase.Do(ctx, in); err != nil {
+			out, err := ir.ReleaseUseCase.Do(ctx, in)
+			if err != nil {
 				slog.Debug("Stacktrace", "stacktrace", err)
 				return fmt.Errorf("could not release the files: %s", err)
 			}
-			return nil
+			return r.writeResult(gOpts, releaseCmdName, newReleaseResult(in, out))
 		},
 	}
 	o.register(c.Flags())
@@ -68,6 +77,8 @@ type releaseOptions struct {
 
 	TagName                 string
 	TargetBranchOrCommitSHA string
+	Include                 []string
+	Exclude                 []string
 	DryRun                  bool
 }
 
@@ -82,5 +93,7 @@ func (o *releaseOptions) register(f *pflag.FlagSet) {
 	o.repositoryOptions.register(f)
 	f.StringVarP(&o.TagName, "tag", "t", "", "Tag name (mandatory)")
 	f.StringVar(&o.TargetBranchOrCommitSHA, "target", "", "Branch name or commit SHA of a tag. Unused if the Git tag already exists (default: the default branch)")
+	f.StringArrayVar(&o.Include, "include", nil, "Glob pattern of the files to include, e.g. dist/**/*.tar.gz (multiple)")
+	f.StringArrayVar(&o.Exclude, "exclude", nil, "Glob pattern of the files or directories to exclude (multiple)")
 	f.BoolVar(&o.DryRun, "dry-run", false, "Do not create a release and assets actually")
 }
@@ -20,7 +20,7 @@ func TestCmd_Run_release(t *testing.T) {
 				TargetBranchOrCommitSHA: "COMMIT_SHA",
 				Paths:                   []string{"file1", "file2"},
 			}).
-			Return(nil)
+			Return(&release.Output{}, nil)
 		r := Runner{
 			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
 			Env:               newEnv(t, map[string]string{envGitHubAPI: ""}),
@@ -41,4 +41,72 @@ func TestCmd_Run_release(t *testing.T) {
 			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
 		}
 	})
+
+	t.Run("GitHub Actions", func(t *testing.T) {
+		releaseUseCase := release_mock.NewMockInterface(t)
+		releaseUseCase.EXPECT().
+			Do(mock.Anything, release.Input{
+				Repository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				TagName:    "v1.0.0",
+				Paths:      []string{"file1", "file2"},
+			}).
+			Return(&release.Output{}, nil)
+		r := Runner{
+			NewGitHub: newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env: newEnv(t, map[string]string{
+				envGitHubAPI:         "",
+				envGitHubActions:     "true",
+				envGitHubRepository:  "owner/repo",
+				envGitHubAPIURL:      "https://api.github.com",
+				envGitHubOutput:      "",
+				envGitHubStepSummary: "",
+			}),
+			NewInternalRunner: newInternalRunner(InternalRunner{ReleaseUseCase: releaseUseCase}),
+		}
+		args := []string{
+			cmdName,
+			releaseCmdName,
+			"--token", "YOUR_TOKEN",
+			"-t", "v1.0.0",
+			"file1",
+			"file2",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("--include and --exclude", func(t *testing.T) {
+		releaseUseCase := release_mock.NewMockInterface(t)
+		releaseUseCase.EXPECT().
+			Do(mock.Anything, release.Input{
+				Repository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				TagName:    "v1.0.0",
+				Paths:      []string{"dist"},
+				Include:    []string{"dist/**/*.tar.gz", "dist/**/*.zip"},
+				Exclude:    []string{"**/*.sbom.tar.gz"},
+			}).
+			Return(&release.Output{}, nil)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, map[string]string{envGitHubAPI: ""}),
+			NewInternalRunner: newInternalRunner(InternalRunner{ReleaseUseCase: releaseUseCase}),
+		}
+		args := []string{
+			cmdName,
+			releaseCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-t", "v1.0.0",
+			"--include", "dist/**/*.tar.gz",
+			"--include", "dist/**/*.zip",
+			"--exclude", "**/*.sbom.tar.gz",
+			"dist",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
 }
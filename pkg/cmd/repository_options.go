@@ -2,20 +2,109 @@ package cmd
 
 import (
 	"fmt"
+	"log/slog"
+	"net/url"
 	"strings"
 
 	"github.com/int128/ghcp/pkg/git"
 	"github.com/spf13/pflag"
 )
 
+const defaultRemoteName = "origin"
+
 type repositoryOptions struct {
 	RepositoryOwner string
 	RepositoryName  string
+	Remote          string
 }
 
 func (o *repositoryOptions) register(f *pflag.FlagSet) {
-	f.StringVarP(&o.RepositoryName, "repo", "r", "", "Repository name, either -r OWNER/REPO or -u OWNER -r REPO (mandatory)")
+	f.StringVarP(&o.RepositoryName, "repo", "r", "", fmt.Sprintf("Repository name, either -r OWNER/REPO or -u OWNER -r REPO (default: $%s on GitHub Actions or the git remote)", envGitHubRepository))
 	f.StringVarP(&o.RepositoryOwner, "owner", "u", "", "Repository owner")
+	f.StringVar(&o.Remote, "remote", "", fmt.Sprintf("Name of the git remote to infer the repository if -r is omitted (default: %s)", defaultRemoteName))
+}
+
+// repositoryDefaults represents the defaults of the flags, inferred with the repository.
+type repositoryDefaults struct {
+	Branch git.BranchName // current branch of the local checkout
+}
+
+// inferRepository sets the repository if -r is omitted.
+// On GitHub Actions, it uses the repository of the workflow.
+// Otherwise, it uses the remote of the local checkout and returns the current branch.
+// The host of the remote must be same as the GitHub API.
+func (r *Runner) inferRepository(o *repositoryOptions, gOpts *globalOptions) (repositoryDefaults, error) {
+	if o.RepositoryName != "" {
+		if o.Remote != "" {
+			return repositoryDefaults{}, fmt.Errorf("do not set both --repo and --remote")
+		}
+		return repositoryDefaults{}, nil
+	}
+	if o.Remote == "" && r.inGitHubActions() {
+		if repository := r.Env.Getenv(envGitHubRepository); repository != "" {
+			o.RepositoryOwner, o.RepositoryName = "", repository
+			slog.Debug("Using the repository of GitHub Actions", "variable", envGitHubRepository, "repository", repository)
+			return repositoryDefaults{}, nil
+		}
+		slog.Debug("No repository of GitHub Actions is inferred because the variable is not set", "variable", envGitHubRepository)
+	}
+	branch, err := r.inferRepositoryFromRemote(o, gOpts)
+	if err != nil {
+		return repositoryDefaults{}, err
+	}
+	return repositoryDefaults{Branch: branch}, nil
+}
+
+func (r *Runner) inferRepositoryFromRemote(o *repositoryOptions, gOpts *globalOptions) (git.BranchName, error) {
+	workingTree, err := r.FileSystem.ReadGitWorkingTree()
+	if err != nil {
+		return "", fmt.Errorf("could not read the local checkout: %w", err)
+	}
+	if workingTree == nil {
+		slog.Debug("No repository is inferred because the current directory is not in a working tree")
+		return "", nil
+	}
+	remote := o.Remote
+	if remote == "" {
+		remote = defaultRemoteName
+	}
+	remoteURL, ok := workingTree.Remotes[remote]
+	if !ok {
+		if o.Remote != "" {
+			return "", fmt.Errorf("no such remote %s in the git config", o.Remote)
+		}
+		slog.Debug("No repository is inferred because the remote is not found", "remote", remote)
+		return "", nil
+	}
+	host, id, err := git.ParseRemoteURL(remoteURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid URL of the remote %s: %w", remote, err)
+	}
+	gitHubHost, err := r.gitHubHost(gOpts)
+	if err != nil {
+		return "", err
+	}
+	if host != gitHubHost {
+		return "", fmt.Errorf("host %s of the remote %s does not match the GitHub host %s (set --api for GitHub Enterprise)", host, remote, gitHubHost)
+	}
+	o.RepositoryOwner, o.RepositoryName = id.Owner, id.Name
+	slog.Info("Using the repository of the git remote", "remote", remote, "repository", id, "branch", workingTree.Branch)
+	return git.BranchName(workingTree.Branch), nil
+}
+
+// gitHubHost returns the host of GitHub, i.e., github.com or the host of GitHub Enterprise.
+func (r *Runner) gitHubHost(gOpts *globalOptions) (string, error) {
+	r.resolveGitHubAPI(gOpts)
+	api := gOpts.GitHubAPI
+	if api == "" {
+		return "github.com", nil
+	}
+	u, err := url.Parse(api)
+	if err != nil {
+		return "", fmt.Errorf("invalid GitHub API URL: %w", err)
+	}
+	// the API of github.com or GHE.com has the prefix api.
+	return strings.TrimPrefix(strings.ToLower(u.Hostname()), "api."), nil
 }
 
 func (o repositoryOptions) repositoryID() (git.RepositoryID, error) {
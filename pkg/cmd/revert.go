@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/spf13/cobra"
+)
+
+const revertCmdExample = `  To revert the changes of the commit on the branch:
+    ghcp revert -r OWNER/REPO -b BRANCH SHA
+
+  If a file changed by the commit has diverged in the branch, it will fail with the list of the files.`
+
+func (r *Runner) newRevertCmd(ctx context.Context, gOpts *globalOptions) *cobra.Command {
+	var o cherryPickOptions
+	c := &cobra.Command{
+		Use:     fmt.Sprintf("%s [flags] SHA", revertCmdName),
+		Short:   "Revert the changes of a commit on the branch",
+		Long:    `This applies the inverse of the changes of the commit against its parent onto the branch, with a message of the revert.`,
+		Example: revertCmdExample,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			in, err := r.cherryPickInput(&o, gOpts, args[0])
+			if err != nil {
+				return fmt.Errorf("invalid flag: %w", err)
+			}
+			in.Revert = true
+
+			ir, err := r.newInternalRunner(gOpts)
+			if err != nil {
+				return fmt.Errorf("error while bootstrap of the dependencies: %w", err)
+			}
+			out, err := ir.CherryPickUseCase.Do(ctx, in)
+			if err != nil {
+				slog.Debug("Stacktrace", "stacktrace", err)
+				return fmt.Errorf("could not revert the commit: %s", err)
+			}
+			return r.writeResult(gOpts, revertCmdName, newCommitResult(out))
+		},
+	}
+	o.registerRevert(c.Flags())
+	return c
+}
@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/int128/ghcp/mocks/github.com/int128/ghcp/pkg/usecases/cherrypick_mock"
+	"github.com/int128/ghcp/pkg/git"
+	"github.com/int128/ghcp/pkg/github/client"
+	"github.com/int128/ghcp/pkg/usecases/cherrypick"
+	"github.com/int128/ghcp/pkg/usecases/commit"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmd_Run_revert(t *testing.T) {
+	t.Run("BasicOptions", func(t *testing.T) {
+		cherryPickUseCase := cherrypick_mock.NewMockInterface(t)
+		cherryPickUseCase.EXPECT().
+			Do(mock.Anything, cherrypick.Input{
+				Repository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				BranchName: "main",
+				CommitSHA:  "commitSHA",
+				Revert:     true,
+				DryRun:     true,
+			}).
+			Return(&commit.Output{}, nil)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, map[string]string{envGitHubAPI: ""}),
+			NewInternalRunner: newInternalRunner(InternalRunner{CherryPickUseCase: cherryPickUseCase}),
+		}
+		args := []string{
+			cmdName,
+			revertCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-b", "main",
+			"--dry-run",
+			"commitSHA",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+	t.Run("--committer-name and --committer-email", func(t *testing.T) {
+		cherryPickUseCase := cherrypick_mock.NewMockInterface(t)
+		cherryPickUseCase.EXPECT().
+			Do(mock.Anything, cherrypick.Input{
+				Repository: git.RepositoryID{Owner: "owner", Name: "repo"},
+				BranchName: "main",
+				CommitSHA:  "commitSHA",
+				Revert:     true,
+				Committer:  &git.CommitAuthor{Name: "Bob", Email: "bob@example.com"},
+			}).
+			Return(&commit.Output{}, nil)
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, map[string]string{envGitHubAPI: ""}),
+			NewInternalRunner: newInternalRunner(InternalRunner{CherryPickUseCase: cherryPickUseCase}),
+		}
+		args := []string{
+			cmdName,
+			revertCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-b", "main",
+			"--committer-name", "Bob",
+			"--committer-email", "bob@example.com",
+			"commitSHA",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeOK {
+			t.Errorf("exitCode wants %d but %d", exitCodeOK, exitCode)
+		}
+	})
+
+	t.Run("--committer-name without --committer-email", func(t *testing.T) {
+		r := Runner{
+			NewGitHub:         newGitHub(t, client.Option{Token: "YOUR_TOKEN"}),
+			Env:               newEnv(t, nil),
+			NewInternalRunner: newInternalRunner(InternalRunner{}),
+		}
+		args := []string{
+			cmdName,
+			revertCmdName,
+			"--token", "YOUR_TOKEN",
+			"-r", "owner/repo",
+			"-b", "main",
+			"--committer-name", "Bob",
+			"commitSHA",
+		}
+		exitCode := r.Run(args, version)
+		if exitCode != exitCodeError {
+			t.Errorf("exitCode wants %d but %d", exitCodeError, exitCode)
+		}
+	})
+}
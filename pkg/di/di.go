@@ -11,6 +11,8 @@ import (
 	"github.com/int128/ghcp/pkg/fs"
 	"github.com/int128/ghcp/pkg/github"
 	"github.com/int128/ghcp/pkg/github/client"
+	"github.com/int128/ghcp/pkg/signer"
+	"github.com/int128/ghcp/pkg/usecases/cherrypick"
 	"github.com/int128/ghcp/pkg/usecases/commit"
 	"github.com/int128/ghcp/pkg/usecases/forkcommit"
 	"github.com/int128/ghcp/pkg/usecases/gitobject"
@@ -23,6 +25,7 @@ func NewCmd() cmd.Interface {
 		cmd.Set,
 		client.Set,
 		env.Set,
+		fs.Set,
 
 		wire.Value(cmd.NewInternalRunnerFunc(NewCmdInternalRunner)),
 	)
@@ -32,12 +35,15 @@ func NewCmd() cmd.Interface {
 func NewCmdInternalRunner(client.Interface) *cmd.InternalRunner {
 	wire.Build(
 		cmd.Set,
+		env.Set,
 		fs.Set,
 		github.Set,
+		signer.Set,
 
 		gitobject.Set,
 		commit.Set,
 		forkcommit.Set,
+		cherrypick.Set,
 		pullrequest.Set,
 		release.Set,
 	)
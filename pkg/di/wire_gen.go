@@ -12,6 +12,8 @@ import (
 	"github.com/int128/ghcp/pkg/fs"
 	"github.com/int128/ghcp/pkg/github"
 	"github.com/int128/ghcp/pkg/github/client"
+	"github.com/int128/ghcp/pkg/signer"
+	"github.com/int128/ghcp/pkg/usecases/cherrypick"
 	"github.com/int128/ghcp/pkg/usecases/commit"
 	"github.com/int128/ghcp/pkg/usecases/forkcommit"
 	"github.com/int128/ghcp/pkg/usecases/gitobject"
@@ -23,10 +25,12 @@ import (
 
 func NewCmd() cmd.Interface {
 	envEnv := &env.Env{}
+	fileSystem := &fs.FileSystem{}
 	newFunc := _wireNewFuncValue
 	newInternalRunnerFunc := _wireNewInternalRunnerFuncValue
 	runner := &cmd.Runner{
 		Env:               envEnv,
+		FileSystem:        fileSystem,
 		NewGitHub:         newFunc,
 		NewInternalRunner: newInternalRunnerFunc,
 	}
@@ -43,19 +47,27 @@ func NewCmdInternalRunner(clientInterface client.Interface) *cmd.InternalRunner
 	gitHub := &github.GitHub{
 		Client: clientInterface,
 	}
+	signerSigner := &signer.Signer{}
 	createGitObject := &gitobject.CreateGitObject{
 		FileSystem: fileSystem,
 		GitHub:     gitHub,
+		Signer:     signerSigner,
 	}
+	envEnv := &env.Env{}
 	commitCommit := &commit.Commit{
 		CreateGitObject: createGitObject,
 		FileSystem:      fileSystem,
 		GitHub:          gitHub,
+		Env:             envEnv,
 	}
 	forkCommit := &forkcommit.ForkCommit{
 		Commit: commitCommit,
 		GitHub: gitHub,
 	}
+	cherryPick := &cherrypick.CherryPick{
+		Commit: commitCommit,
+		GitHub: gitHub,
+	}
 	pullRequest := &pullrequest.PullRequest{
 		GitHub: gitHub,
 	}
@@ -66,6 +78,7 @@ func NewCmdInternalRunner(clientInterface client.Interface) *cmd.InternalRunner
 	internalRunner := &cmd.InternalRunner{
 		CommitUseCase:      commitCommit,
 		ForkCommitUseCase:  forkCommit,
+		CherryPickUseCase:  cherryPick,
 		PullRequestUseCase: pullRequest,
 		ReleaseUseCase:     releaseRelease,
 	}
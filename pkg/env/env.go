@@ -1,6 +1,7 @@
 package env
 
 import (
+	"io"
 	"os"
 
 	"github.com/google/wire"
@@ -14,10 +15,12 @@ var Set = wire.NewSet(
 type Interface interface {
 	Getenv(key string) string
 	Chdir(dir string) error
+	Stdout() io.Writer
+	AppendFile(name string, data []byte) error
 }
 
 // Env provides environment dependencies,
-// such as environment variables and current directory.
+// such as environment variables, current directory and standard output.
 type Env struct{}
 
 func (e *Env) Getenv(key string) string {
@@ -27,3 +30,22 @@ func (e *Env) Getenv(key string) string {
 func (e *Env) Chdir(dir string) error {
 	return os.Chdir(dir)
 }
+
+func (e *Env) Stdout() io.Writer {
+	return os.Stdout
+}
+
+// AppendFile appends the data to the file, creating it if it does not exist.
+func (e *Env) AppendFile(name string, data []byte) (err error) {
+	f, err := os.OpenFile(name, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	}()
+	_, err = f.Write(data)
+	return err
+}
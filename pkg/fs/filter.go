@@ -0,0 +1,82 @@
+package fs
+
+import (
+	"fmt"
+	"log/slog"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Filters is a composite filter.
+// It skips a directory or excludes a file if any of the filters does.
+type Filters []FindFilesFilter
+
+func (filters Filters) SkipDir(path string) bool {
+	for _, f := range filters {
+		if f.SkipDir(path) {
+			return true
+		}
+	}
+	return false
+}
+
+func (filters Filters) ExcludeFile(path string) bool {
+	for _, f := range filters {
+		if f.ExcludeFile(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// GlobFilter is a filter to include or exclude the files by glob patterns.
+// A pattern is matched to the whole path of a file, and "**" matches zero or more directories.
+// For example, dist/**/*.tar.gz matches dist/a.tar.gz and dist/linux/amd64/a.tar.gz.
+type GlobFilter struct {
+	Include []string // if set, exclude the files which do not match any of them
+	Exclude []string // exclude the files or directories which match any of them
+}
+
+// Validate returns an error if any pattern is malformed.
+func (f GlobFilter) Validate() error {
+	for _, pattern := range append(f.Include, f.Exclude...) {
+		for _, segment := range strings.Split(pattern, "/") {
+			if _, err := path.Match(segment, ""); err != nil {
+				return fmt.Errorf("invalid pattern %s: %w", pattern, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (f GlobFilter) SkipDir(path string) bool {
+	if matchAnyGlob(f.Exclude, path) {
+		slog.Debug("Exclude the directory", "path", path)
+		return true
+	}
+	return false
+}
+
+func (f GlobFilter) ExcludeFile(path string) bool {
+	if matchAnyGlob(f.Exclude, path) {
+		slog.Debug("Exclude the file", "path", path)
+		return true
+	}
+	if len(f.Include) > 0 && !matchAnyGlob(f.Include, path) {
+		slog.Debug("Exclude the file not included", "path", path)
+		return true
+	}
+	return false
+}
+
+func matchAnyGlob(patterns []string, name string) bool {
+	name = path.Clean(filepath.ToSlash(name))
+	for _, pattern := range patterns {
+		pattern = path.Clean(filepath.ToSlash(pattern))
+		if matchSegments(strings.Split(pattern, "/"), strings.Split(name, "/")) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package fs
+
+import "testing"
+
+func TestGlobFilter(t *testing.T) {
+	f := GlobFilter{
+		Include: []string{"dist/**/*.tar.gz", "README.md"},
+		Exclude: []string{"dist/tmp", "**/*.sbom.tar.gz"},
+	}
+	for _, c := range []struct {
+		path    string
+		exclude bool
+	}{
+		{"README.md", false},
+		{"./README.md", false},
+		{"docs/README.md", true},
+		{"dist/a.tar.gz", false},
+		{"dist/linux/amd64/a.tar.gz", false},
+		{"dist/linux/amd64/a.zip", true},
+		{"dist/linux/a.sbom.tar.gz", true},
+	} {
+		t.Run(c.path, func(t *testing.T) {
+			if got := f.ExcludeFile(c.path); got != c.exclude {
+				t.Errorf("ExcludeFile(%s) wants %v but %v", c.path, c.exclude, got)
+			}
+		})
+	}
+	for _, c := range []struct {
+		path string
+		skip bool
+	}{
+		{"dist", false},
+		{"dist/tmp", true},
+		{"dist/linux", false},
+	} {
+		t.Run(c.path, func(t *testing.T) {
+			if got := f.SkipDir(c.path); got != c.skip {
+				t.Errorf("SkipDir(%s) wants %v but %v", c.path, c.skip, got)
+			}
+		})
+	}
+}
+
+func TestGlobFilter_Validate(t *testing.T) {
+	if err := (GlobFilter{Include: []string{"dist/**/*.tar.gz"}}).Validate(); err != nil {
+		t.Errorf("Validate returned error: %s", err)
+	}
+	if err := (GlobFilter{Exclude: []string{"dist/[a"}}).Validate(); err == nil {
+		t.Errorf("Validate wants error but nil")
+	}
+}
+
+func TestFilters(t *testing.T) {
+	filters := Filters{
+		GlobFilter{Exclude: []string{"a"}},
+		GlobFilter{Exclude: []string{"b"}},
+	}
+	for _, c := range []struct {
+		path    string
+		exclude bool
+	}{
+		{"a", true},
+		{"b", true},
+		{"c", false},
+	} {
+		t.Run(c.path, func(t *testing.T) {
+			if got := filters.ExcludeFile(c.path); got != c.exclude {
+				t.Errorf("ExcludeFile(%s) wants %v but %v", c.path, c.exclude, got)
+			}
+			if got := filters.SkipDir(c.path); got != c.exclude {
+				t.Errorf("SkipDir(%s) wants %v but %v", c.path, c.exclude, got)
+			}
+		})
+	}
+}
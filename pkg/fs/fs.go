@@ -1,13 +1,14 @@
 package fs
 
 import (
-	"encoding/base64"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log/slog"
 	"os"
 	"path/filepath"
-	"strings"
 
 	"github.com/google/wire"
 )
@@ -19,7 +20,15 @@ var Set = wire.NewSet(
 
 type Interface interface {
 	FindFiles(paths []string, filter FindFilesFilter) ([]File, error)
-	ReadAsBase64EncodedContent(filename string) (string, error)
+	Open(filename string) (io.ReadCloser, error)
+	ReadFile(filename string) ([]byte, error)
+	Stdin() io.Reader
+	ComputeGitBlobSHA(filename string) (string, error)
+	ComputeSHA256(filename string) (string, error)
+	ReadLFSRules(filename string) (LFSRules, error)
+	NewGitIgnoreFilter() (FindFilesFilter, error)
+	ReadGitConfig() (*GitConfig, error)
+	ReadGitWorkingTree() (*GitWorkingTree, error)
 }
 
 // FindFilesFilter is an interface to filter directories and files.
@@ -36,6 +45,7 @@ func (*nullFindFilesFilter) ExcludeFile(string) bool { return false }
 type File struct {
 	Path       string
 	Executable bool
+	Size       int64
 }
 
 // FileSystem provides manipulation of file system.
@@ -66,6 +76,7 @@ func (fs *FileSystem) FindFiles(paths []string, filter FindFilesFilter) ([]File,
 				files = append(files, File{
 					Path:       path,
 					Executable: info.Mode()&0100 != 0, // mask the executable bit of owner
+					Size:       info.Size(),
 				})
 				return nil
 			}
@@ -77,8 +88,29 @@ func (fs *FileSystem) FindFiles(paths []string, filter FindFilesFilter) ([]File,
 	return files, nil
 }
 
-// ReadAsBase64EncodedContent returns content of the file as base64 encoded string.
-func (fs *FileSystem) ReadAsBase64EncodedContent(filename string) (string, error) {
+// Open opens the file for reading.
+// The caller must close it.
+func (fs *FileSystem) Open(filename string) (io.ReadCloser, error) {
+	r, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error while opening file %s: %w", filename, err)
+	}
+	return r, nil
+}
+
+// ReadFile returns the content of the file.
+func (fs *FileSystem) ReadFile(filename string) ([]byte, error) {
+	return os.ReadFile(filename)
+}
+
+// Stdin returns the standard input.
+func (fs *FileSystem) Stdin() io.Reader {
+	return os.Stdin
+}
+
+// ComputeGitBlobSHA returns SHA-1 of the file in the format of Git blob object,
+// i.e. same as git hash-object.
+func (fs *FileSystem) ComputeGitBlobSHA(filename string) (string, error) {
 	r, err := os.Open(filename)
 	if err != nil {
 		return "", fmt.Errorf("error while opening file %s: %w", filename, err)
@@ -88,13 +120,34 @@ func (fs *FileSystem) ReadAsBase64EncodedContent(filename string) (string, error
 			slog.Error("Failed to close the file", "error", err)
 		}
 	}()
-	var s strings.Builder
-	e := base64.NewEncoder(base64.StdEncoding, &s)
-	if _, err := io.Copy(e, r); err != nil {
-		return "", fmt.Errorf("error while encoding file %s: %w", filename, err)
+	info, err := r.Stat()
+	if err != nil {
+		return "", fmt.Errorf("error while getting size of file %s: %w", filename, err)
+	}
+	h := sha1.New()
+	if _, err := fmt.Fprintf(h, "blob %d\x00", info.Size()); err != nil {
+		return "", fmt.Errorf("error while computing hash of file %s: %w", filename, err)
+	}
+	if _, err := io.Copy(h, r); err != nil {
+		return "", fmt.Errorf("error while computing hash of file %s: %w", filename, err)
 	}
-	if err := e.Close(); err != nil {
-		return "", fmt.Errorf("error while encoding file %s: %w", filename, err)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// ComputeSHA256 returns SHA-256 of the file.
+func (fs *FileSystem) ComputeSHA256(filename string) (string, error) {
+	r, err := os.Open(filename)
+	if err != nil {
+		return "", fmt.Errorf("error while opening file %s: %w", filename, err)
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			slog.Error("Failed to close the file", "error", err)
+		}
+	}()
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", fmt.Errorf("error while computing hash of file %s: %w", filename, err)
 	}
-	return s.String(), nil
+	return hex.EncodeToString(h.Sum(nil)), nil
 }
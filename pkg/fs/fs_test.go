@@ -1,6 +1,7 @@
 package fs
 
 import (
+	"io"
 	"os"
 	"path/filepath"
 	"testing"
@@ -44,7 +45,7 @@ func TestFileSystem_FindFiles(t *testing.T) {
 	if err := os.WriteFile("dir2/b.jpg", []byte{}, 0644); err != nil {
 		t.Fatal(err)
 	}
-	if err := os.WriteFile("dir2/c.jpg", []byte{}, 0755); err != nil {
+	if err := os.WriteFile("dir2/c.jpg", []byte("c"), 0755); err != nil {
 		t.Fatal(err)
 	}
 
@@ -56,7 +57,7 @@ func TestFileSystem_FindFiles(t *testing.T) {
 		want := []File{
 			{Path: "dir1/a.jpg"},
 			{Path: "dir2/b.jpg"},
-			{Path: "dir2/c.jpg", Executable: true},
+			{Path: "dir2/c.jpg", Executable: true, Size: 1},
 		}
 		if diff := cmp.Diff(want, got); diff != "" {
 			t.Errorf("mismatch (-want +got):\n%s", diff)
@@ -70,7 +71,7 @@ func TestFileSystem_FindFiles(t *testing.T) {
 		want := []File{
 			{Path: "dir1/a.jpg"},
 			{Path: "dir2/b.jpg"},
-			{Path: "dir2/c.jpg", Executable: true},
+			{Path: "dir2/c.jpg", Executable: true, Size: 1},
 		}
 		if diff := cmp.Diff(want, got); diff != "" {
 			t.Errorf("mismatch (-want +got):\n%s", diff)
@@ -83,7 +84,7 @@ func TestFileSystem_FindFiles(t *testing.T) {
 		}
 		want := []File{
 			{Path: "dir1/a.jpg"},
-			{Path: "dir2/c.jpg", Executable: true},
+			{Path: "dir2/c.jpg", Executable: true, Size: 1},
 		}
 		if diff := cmp.Diff(want, got); diff != "" {
 			t.Errorf("mismatch (-want +got):\n%s", diff)
@@ -117,7 +118,7 @@ func TestFileSystem_FindFiles(t *testing.T) {
 		}
 		want := []File{
 			{Path: "dir1/a.jpg"},
-			{Path: "dir2/c.jpg", Executable: true},
+			{Path: "dir2/c.jpg", Executable: true, Size: 1},
 		}
 		if diff := cmp.Diff(want, got); diff != "" {
 			t.Errorf("mismatch (-want +got):\n%s", diff)
@@ -125,19 +126,60 @@ func TestFileSystem_FindFiles(t *testing.T) {
 	})
 }
 
-func TestFileSystem_ReadAsBase64EncodedContent(t *testing.T) {
+func TestFileSystem_Open(t *testing.T) {
 	fs := &FileSystem{}
 	tempDir := t.TempDir()
 	tempFile := filepath.Join(tempDir, "fs_test")
 	if err := os.WriteFile(tempFile, []byte("hello\nworld"), 0644); err != nil {
 		t.Fatal(err)
 	}
-	content, err := fs.ReadAsBase64EncodedContent(tempFile)
+	r, err := fs.Open(tempFile)
 	if err != nil {
-		t.Fatalf("ReadAsBase64EncodedContent returned error: %+v", err)
+		t.Fatalf("Open returned error: %+v", err)
 	}
-	want := "aGVsbG8Kd29ybGQ="
-	if want != content {
+	defer r.Close()
+	content, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("ReadAll returned error: %+v", err)
+	}
+	want := "hello\nworld"
+	if want != string(content) {
 		t.Errorf("content wants %s but %s", want, content)
 	}
 }
+
+func TestFileSystem_ComputeGitBlobSHA(t *testing.T) {
+	fs := &FileSystem{}
+	tempDir := t.TempDir()
+	tempFile := filepath.Join(tempDir, "fs_test")
+	if err := os.WriteFile(tempFile, []byte("hello\nworld"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	sha, err := fs.ComputeGitBlobSHA(tempFile)
+	if err != nil {
+		t.Fatalf("ComputeGitBlobSHA returned error: %+v", err)
+	}
+	// printf 'hello\nworld' | git hash-object --stdin
+	want := "9db7df02b6026626607ed9643ea24af9dc09c2c9"
+	if want != sha {
+		t.Errorf("sha wants %s but %s", want, sha)
+	}
+}
+
+func TestFileSystem_ComputeSHA256(t *testing.T) {
+	fs := &FileSystem{}
+	tempDir := t.TempDir()
+	tempFile := filepath.Join(tempDir, "fs_test")
+	if err := os.WriteFile(tempFile, []byte("hello\nworld"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	sha, err := fs.ComputeSHA256(tempFile)
+	if err != nil {
+		t.Fatalf("ComputeSHA256 returned error: %+v", err)
+	}
+	// printf 'hello\nworld' | sha256sum
+	want := "26c60a61d01db5836ca70fefd44a6a016620413c8ef5f259a6c5612d4f79d3b8"
+	if want != sha {
+		t.Errorf("sha wants %s but %s", want, sha)
+	}
+}
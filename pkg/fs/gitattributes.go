@@ -0,0 +1,78 @@
+package fs
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// LFSRule represents a line of .gitattributes which sets or unsets the filter.
+type LFSRule struct {
+	Pattern string
+	LFS     bool // true if filter=lfs
+}
+
+// LFSRules represents the rules of .gitattributes in order.
+type LFSRules []LFSRule
+
+// Match returns true if the file should be stored in Git LFS.
+// The filename must be slash-separated and relative to the root of the repository.
+// As well as Git, the last matched rule wins.
+func (rules LFSRules) Match(filename string) bool {
+	var lfs bool
+	for _, rule := range rules {
+		if matchPattern(rule.Pattern, filename) {
+			lfs = rule.LFS
+		}
+	}
+	return lfs
+}
+
+// ReadLFSRules returns the rules of the filter in the .gitattributes file.
+// It returns nil if the file does not exist.
+func (fs *FileSystem) ReadLFSRules(filename string) (LFSRules, error) {
+	f, err := os.Open(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error while opening file %s: %w", filename, err)
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			slog.Error("Failed to close the file", "error", err)
+		}
+	}()
+	rules, err := parseLFSRules(f)
+	if err != nil {
+		return nil, fmt.Errorf("error while reading file %s: %w", filename, err)
+	}
+	return rules, nil
+}
+
+func parseLFSRules(r io.Reader) (LFSRules, error) {
+	var rules LFSRules
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || strings.HasPrefix(fields[0], "#") || strings.HasPrefix(fields[0], "[attr]") {
+			continue
+		}
+		for _, attr := range fields[1:] {
+			switch {
+			case attr == "filter=lfs":
+				rules = append(rules, LFSRule{Pattern: fields[0], LFS: true})
+			case strings.HasPrefix(attr, "filter="), attr == "-filter", attr == "!filter":
+				rules = append(rules, LFSRule{Pattern: fields[0]})
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
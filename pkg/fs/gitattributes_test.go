@@ -0,0 +1,58 @@
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFileSystem_ReadLFSRules(t *testing.T) {
+	fs := &FileSystem{}
+
+	t.Run("Exists", func(t *testing.T) {
+		tempFile := filepath.Join(t.TempDir(), ".gitattributes")
+		if err := os.WriteFile(tempFile, []byte(`# comment
+*.bin filter=lfs diff=lfs merge=lfs -text
+*.txt text
+assets/** filter=lfs diff=lfs merge=lfs -text
+assets/small.png -filter
+`), 0644); err != nil {
+			t.Fatal(err)
+		}
+		rules, err := fs.ReadLFSRules(tempFile)
+		if err != nil {
+			t.Fatalf("ReadLFSRules returned error: %+v", err)
+		}
+		want := LFSRules{
+			{Pattern: "*.bin", LFS: true},
+			{Pattern: "assets/**", LFS: true},
+			{Pattern: "assets/small.png"},
+		}
+		if diff := cmp.Diff(want, rules); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+		for filename, lfs := range map[string]bool{
+			"a.bin":            true,
+			"dir/a.bin":        true,
+			"a.txt":            false,
+			"assets/large.png": true,
+			"assets/small.png": false,
+		} {
+			if rules.Match(filename) != lfs {
+				t.Errorf("Match(%s) wants %v", filename, lfs)
+			}
+		}
+	})
+
+	t.Run("NotExist", func(t *testing.T) {
+		rules, err := fs.ReadLFSRules(filepath.Join(t.TempDir(), ".gitattributes"))
+		if err != nil {
+			t.Fatalf("ReadLFSRules returned error: %+v", err)
+		}
+		if rules != nil {
+			t.Errorf("rules wants nil but %+v", rules)
+		}
+	})
+}
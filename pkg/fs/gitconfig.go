@@ -0,0 +1,357 @@
+package fs
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// GitConfig represents the variables in the git config files.
+type GitConfig struct {
+	UserName  string // user.name
+	UserEmail string // user.email
+}
+
+// maxGitConfigIncludeDepth is the limit of nested includes, same as Git.
+const maxGitConfigIncludeDepth = 10
+
+// ReadGitConfig reads the git config files in the same order as Git, i.e.,
+// $XDG_CONFIG_HOME/git/config, ~/.gitconfig and .git/config of the working tree.
+// A variable in a later file takes precedence.
+// It follows include.path and includeIf.<condition>.path with the conditions of gitdir, gitdir/i and onbranch.
+// It ignores a file which does not exist.
+func (fs *FileSystem) ReadGitConfig() (*GitConfig, error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("error while getting the current directory: %w", err)
+	}
+	r := gitConfigReader{vars: make(map[string]string)}
+	r.home, err = os.UserHomeDir()
+	if err != nil {
+		slog.Debug("Skip the global git config", "error", err)
+	}
+	r.gitDir = findGitDir(wd)
+	if r.gitDir != "" {
+		r.branch = readGitHeadBranch(r.gitDir)
+	}
+
+	var filenames []string
+	if xdgConfigHome := os.Getenv("XDG_CONFIG_HOME"); xdgConfigHome != "" {
+		filenames = append(filenames, filepath.Join(xdgConfigHome, "git", "config"))
+	} else if r.home != "" {
+		filenames = append(filenames, filepath.Join(r.home, ".config", "git", "config"))
+	}
+	if r.home != "" {
+		filenames = append(filenames, filepath.Join(r.home, ".gitconfig"))
+	}
+	if r.gitDir != "" {
+		filenames = append(filenames, filepath.Join(gitCommonDir(r.gitDir), "config"))
+	}
+	for _, filename := range filenames {
+		if err := r.readFile(filename, 0); err != nil {
+			return nil, err
+		}
+	}
+	return &GitConfig{
+		UserName:  r.vars["user.name"],
+		UserEmail: r.vars["user.email"],
+	}, nil
+}
+
+// GitWorkingTree represents the local checkout.
+type GitWorkingTree struct {
+	Remotes map[string]string // URL of the remote by the name
+	Branch  string            // current branch, or empty if detached
+}
+
+// ReadGitWorkingTree returns the remotes in .git/config and the branch of .git/HEAD.
+// It finds the working tree from the current directory to the root.
+// It returns nil if no working tree is found.
+func (fs *FileSystem) ReadGitWorkingTree() (*GitWorkingTree, error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("error while getting the current directory: %w", err)
+	}
+	gitDir := findGitDir(wd)
+	if gitDir == "" {
+		return nil, nil
+	}
+	r := gitConfigReader{vars: make(map[string]string), gitDir: gitDir, branch: readGitHeadBranch(gitDir)}
+	r.home, _ = os.UserHomeDir()
+	if err := r.readFile(filepath.Join(gitCommonDir(gitDir), "config"), 0); err != nil {
+		return nil, err
+	}
+	workingTree := GitWorkingTree{Remotes: make(map[string]string), Branch: r.branch}
+	for key, value := range r.vars {
+		if name, ok := strings.CutPrefix(key, "remote."); ok {
+			if name, ok := strings.CutSuffix(name, ".url"); ok {
+				workingTree.Remotes[name] = value
+			}
+		}
+	}
+	return &workingTree, nil
+}
+
+type gitConfigReader struct {
+	home   string            // home directory, or empty if unknown
+	gitDir string            // absolute path to the .git directory, or empty if not in a working tree
+	branch string            // current branch, or empty if detached
+	vars   map[string]string // by the key such as user.name
+}
+
+func (r *gitConfigReader) readFile(filename string, depth int) error {
+	if depth > maxGitConfigIncludeDepth {
+		return fmt.Errorf("exceeded the maximum include depth (%d) while including %s", maxGitConfigIncludeDepth, filename)
+	}
+	b, err := os.ReadFile(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error while reading file %s: %w", filename, err)
+	}
+	slog.Debug("Reading the git config", "file", filename)
+	var includeErr error
+	if err := parseGitConfig(string(b), func(section, subsection, name, value string) {
+		switch {
+		case includeErr != nil:
+		case section == "include" && subsection == "" && name == "path":
+			// an included file is processed at the position of the include, same as Git
+			includeErr = r.readFile(r.resolvePath(value, filename), depth+1)
+		case section == "includeif" && name == "path":
+			if r.matchCondition(subsection, filename) {
+				includeErr = r.readFile(r.resolvePath(value, filename), depth+1)
+			}
+		case subsection == "":
+			r.vars[section+"."+name] = value
+		default:
+			r.vars[section+"."+subsection+"."+name] = value
+		}
+	}); err != nil {
+		return fmt.Errorf("invalid git config %s: %w", filename, err)
+	}
+	return includeErr
+}
+
+// resolvePath returns the path relative to the directory of the config file.
+func (r *gitConfigReader) resolvePath(p, configFilename string) string {
+	if strings.HasPrefix(p, "~/") && r.home != "" {
+		return filepath.Join(r.home, p[2:])
+	}
+	if filepath.IsAbs(p) {
+		return p
+	}
+	return filepath.Join(filepath.Dir(configFilename), p)
+}
+
+// matchCondition returns true if the condition of includeIf is met.
+// https://git-scm.com/docs/git-config#_conditional_includes
+func (r *gitConfigReader) matchCondition(condition, configFilename string) bool {
+	kind, pattern, ok := strings.Cut(condition, ":")
+	if !ok {
+		return false
+	}
+	switch kind {
+	case "gitdir", "gitdir/i":
+		if r.gitDir == "" {
+			return false
+		}
+		switch {
+		case strings.HasPrefix(pattern, "~/") && r.home != "":
+			pattern = filepath.ToSlash(r.home) + pattern[1:]
+		case strings.HasPrefix(pattern, "./"):
+			pattern = filepath.ToSlash(filepath.Dir(configFilename)) + pattern[1:]
+		case !strings.HasPrefix(pattern, "/"):
+			pattern = "**/" + pattern
+		}
+		if strings.HasSuffix(pattern, "/") {
+			pattern += "**"
+		}
+		gitDir := filepath.ToSlash(r.gitDir)
+		if kind == "gitdir/i" {
+			pattern, gitDir = strings.ToLower(pattern), strings.ToLower(gitDir)
+		}
+		return matchSegments(strings.Split(pattern, "/"), strings.Split(gitDir, "/"))
+	case "onbranch":
+		if r.branch == "" {
+			return false
+		}
+		if strings.HasSuffix(pattern, "/") {
+			pattern += "**"
+		}
+		return matchSegments(strings.Split(pattern, "/"), strings.Split(r.branch, "/"))
+	}
+	slog.Debug("Skip the unsupported condition of includeIf", "condition", condition)
+	return false
+}
+
+// parseGitConfig parses the content in the format of git config.
+// The section and name are converted to lower case.
+// https://git-scm.com/docs/git-config#_syntax
+func parseGitConfig(content string, fn func(section, subsection, name, value string)) error {
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	var section, subsection string
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		if strings.HasPrefix(line, "[") {
+			end := strings.LastIndex(line, "]")
+			if end < 0 {
+				return fmt.Errorf("line %d: missing ] of the section", i+1)
+			}
+			header := line[1:end]
+			line = strings.TrimSpace(line[end+1:])
+			if name, sub, ok := strings.Cut(header, " "); ok {
+				sub = strings.TrimSpace(sub)
+				if len(sub) < 2 || !strings.HasPrefix(sub, `"`) || !strings.HasSuffix(sub, `"`) {
+					return fmt.Errorf("line %d: subsection must be quoted", i+1)
+				}
+				section = strings.ToLower(name)
+				subsection = strings.NewReplacer(`\"`, `"`, `\\`, `\`).Replace(sub[1 : len(sub)-1])
+			} else if name, sub, ok := strings.Cut(header, "."); ok {
+				// deprecated syntax of [section.subsection]
+				section, subsection = strings.ToLower(name), strings.ToLower(sub)
+			} else {
+				section, subsection = strings.ToLower(header), ""
+			}
+		}
+		if line == "" || line[0] == '#' || line[0] == ';' {
+			continue
+		}
+		if section == "" {
+			return fmt.Errorf("line %d: variable must be in a section", i+1)
+		}
+		name, raw, hasValue := strings.Cut(line, "=")
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !hasValue {
+			// a variable without value is a boolean true
+			fn(section, subsection, name, "true")
+			continue
+		}
+		// a backslash at the end of line continues the value to the next line
+		for hasContinuation(raw) && i+1 < len(lines) {
+			i++
+			raw = raw[:len(raw)-1] + lines[i]
+		}
+		value, err := parseGitConfigValue(raw)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", i+1, err)
+		}
+		fn(section, subsection, name, value)
+	}
+	return nil
+}
+
+func hasContinuation(s string) bool {
+	n := len(s) - len(strings.TrimRight(s, `\`))
+	return n%2 == 1
+}
+
+// parseGitConfigValue returns the value with quotes and escapes resolved.
+// Whitespaces at the beginning and end are removed unless they are quoted.
+func parseGitConfigValue(raw string) (string, error) {
+	var b strings.Builder
+	var spaces strings.Builder // pending whitespaces, written if followed by a character
+	var quoted bool
+	for i := 0; i < len(raw); i++ {
+		c := raw[i]
+		switch {
+		case !quoted && (c == '#' || c == ';'):
+			return b.String(), nil
+		case !quoted && (c == ' ' || c == '\t'):
+			if b.Len() > 0 {
+				spaces.WriteByte(c)
+			}
+			continue
+		case c == '"':
+			quoted = !quoted
+			continue
+		}
+		b.WriteString(spaces.String())
+		spaces.Reset()
+		if c != '\\' {
+			b.WriteByte(c)
+			continue
+		}
+		i++
+		if i >= len(raw) {
+			return "", errors.New("incomplete escape sequence")
+		}
+		switch raw[i] {
+		case 'n':
+			b.WriteByte('\n')
+		case 't':
+			b.WriteByte('\t')
+		case 'b':
+			b.WriteByte('\b')
+		case '"', '\\':
+			b.WriteByte(raw[i])
+		default:
+			return "", fmt.Errorf("invalid escape sequence \\%c", raw[i])
+		}
+	}
+	if quoted {
+		return "", errors.New("missing closing quote")
+	}
+	return b.String(), nil
+}
+
+// findGitDir returns the absolute path to the .git directory from the directory to the root.
+// If .git is a file, such as a worktree or submodule, it follows the gitdir in the file.
+// It returns an empty string if no working tree is found.
+func findGitDir(dir string) string {
+	for d := dir; ; d = filepath.Dir(d) {
+		dotGit := filepath.Join(d, ".git")
+		if info, err := os.Stat(dotGit); err == nil {
+			if info.IsDir() {
+				return dotGit
+			}
+			b, err := os.ReadFile(dotGit)
+			if err != nil {
+				slog.Debug("Skip the invalid .git file", "file", dotGit, "error", err)
+				return ""
+			}
+			gitDir, ok := strings.CutPrefix(strings.TrimSpace(string(b)), "gitdir: ")
+			if !ok {
+				slog.Debug("Skip the invalid .git file", "file", dotGit)
+				return ""
+			}
+			if !filepath.IsAbs(gitDir) {
+				gitDir = filepath.Join(d, gitDir)
+			}
+			return filepath.Clean(gitDir)
+		}
+		if filepath.Dir(d) == d {
+			return ""
+		}
+	}
+}
+
+// gitCommonDir returns the directory shared by the worktrees.
+// It returns the given directory if it is not a worktree.
+func gitCommonDir(gitDir string) string {
+	b, err := os.ReadFile(filepath.Join(gitDir, "commondir"))
+	if err != nil {
+		return gitDir
+	}
+	commonDir := strings.TrimSpace(string(b))
+	if !filepath.IsAbs(commonDir) {
+		commonDir = filepath.Join(gitDir, commonDir)
+	}
+	return filepath.Clean(commonDir)
+}
+
+// readGitHeadBranch returns the current branch of HEAD, or an empty string if detached.
+func readGitHeadBranch(gitDir string) string {
+	b, err := os.ReadFile(filepath.Join(gitDir, "HEAD"))
+	if err != nil {
+		return ""
+	}
+	branch, ok := strings.CutPrefix(strings.TrimSpace(string(b)), "ref: refs/heads/")
+	if !ok {
+		return ""
+	}
+	return branch
+}
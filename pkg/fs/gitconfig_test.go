@@ -0,0 +1,132 @@
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFileSystem_ReadGitConfig(t *testing.T) {
+	fs := &FileSystem{}
+	home := t.TempDir()
+	for name, content := range map[string]string{
+		".config/git/config": "[user]\n\tname = XDG User\n\temail = xdg@example.com\n",
+		".gitconfig": "[user]\n" +
+			"\tname = \"Global User\" # comment\n" +
+			"[includeIf \"gitdir:~/work/\"]\n" +
+			"\tpath = .gitconfig-work\n" +
+			"[includeIf \"gitdir:~/personal/\"]\n" +
+			"\tpath = .gitconfig-personal\n",
+		".gitconfig-work":                           "[user]\n\temail = work@example.com\n",
+		".gitconfig-personal":                       "[user]\n\temail = personal@example.com\n",
+		"work/app/.git/HEAD":                        "ref: refs/heads/main\n",
+		"work/app/.git/config":                      "[core]\n\tbare = false\n[includeIf \"onbranch:release/\"]\n\tpath = release.inc\n",
+		"work/app/.git/release.inc":                 "[user]\n\tname = Release Bot\n",
+		"work/app/src/main.go":                      "",
+		"work/release/.git/HEAD":                    "ref: refs/heads/release/v1\n",
+		"work/release/.git/config":                  "[includeIf \"onbranch:release/\"]\n\tpath = release.inc\n",
+		"work/release/.git/release.inc":             "[user]\n\tname = Release Bot\n",
+		"personal/repo/.git/HEAD":                   "ref: refs/heads/main\n",
+		"personal/repo/.git/config":                 "[user]\n\tname = Local \\\n  User\n",
+		"personal/worktree/.git":                    "gitdir: ../repo/.git/worktrees/wt\n",
+		"personal/repo/.git/worktrees/wt/HEAD":      "ref: refs/heads/topic\n",
+		"personal/repo/.git/worktrees/wt/commondir": "../..\n",
+	} {
+		filename := filepath.Join(home, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", "")
+
+	for _, c := range []struct {
+		dir  string
+		want GitConfig
+	}{
+		{dir: ".", want: GitConfig{UserName: "Global User", UserEmail: "xdg@example.com"}},
+		{dir: "work/app/src", want: GitConfig{UserName: "Global User", UserEmail: "work@example.com"}},
+		{dir: "work/release", want: GitConfig{UserName: "Release Bot", UserEmail: "work@example.com"}},
+		{dir: "personal/repo", want: GitConfig{UserName: "Local   User", UserEmail: "personal@example.com"}},
+		{dir: "personal/worktree", want: GitConfig{UserName: "Local   User", UserEmail: "personal@example.com"}},
+	} {
+		t.Run(c.dir, func(t *testing.T) {
+			t.Chdir(filepath.Join(home, filepath.FromSlash(c.dir)))
+			got, err := fs.ReadGitConfig()
+			if err != nil {
+				t.Fatalf("ReadGitConfig returned error: %+v", err)
+			}
+			if diff := cmp.Diff(&c.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_parseGitConfigValue(t *testing.T) {
+	for raw, want := range map[string]string{
+		` value `:                 `value`,
+		` two  words # comment`:   `two  words`,
+		` " quoted # value " ; c`: ` quoted # value `,
+		` tab\tand\\backslash`:    "tab\tand\\backslash",
+	} {
+		t.Run(raw, func(t *testing.T) {
+			got, err := parseGitConfigValue(raw)
+			if err != nil {
+				t.Fatalf("parseGitConfigValue returned error: %+v", err)
+			}
+			if got != want {
+				t.Errorf("parseGitConfigValue wants %q but %q", want, got)
+			}
+		})
+	}
+	for _, raw := range []string{` "unclosed`, ` invalid \x`} {
+		t.Run(raw, func(t *testing.T) {
+			if _, err := parseGitConfigValue(raw); err == nil {
+				t.Errorf("err wants non-nil but nil")
+			}
+		})
+	}
+}
+
+func TestFileSystem_ReadGitWorkingTree(t *testing.T) {
+	fs := &FileSystem{}
+	tempDir := t.TempDir()
+	for name, content := range map[string]string{
+		".git/HEAD": "ref: refs/heads/feature/x\n",
+		".git/config": "[core]\n\tbare = false\n" +
+			"[remote \"origin\"]\n\turl = git@github.com:owner/repo.git\n\tfetch = +refs/heads/*:refs/remotes/origin/*\n" +
+			"[remote \"upstream\"]\n\turl = https://github.com/upstream/repo\n" +
+			"[branch \"main\"]\n\tremote = origin\n",
+		"src/main.go": "",
+	} {
+		filename := filepath.Join(tempDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Chdir(filepath.Join(tempDir, "src"))
+
+	got, err := fs.ReadGitWorkingTree()
+	if err != nil {
+		t.Fatalf("ReadGitWorkingTree returned error: %+v", err)
+	}
+	want := &GitWorkingTree{
+		Remotes: map[string]string{
+			"origin":   "git@github.com:owner/repo.git",
+			"upstream": "https://github.com/upstream/repo",
+		},
+		Branch: "feature/x",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
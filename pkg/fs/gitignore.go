@@ -0,0 +1,198 @@
+package fs
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+type gitIgnoreRule struct {
+	pattern string
+	negate  bool // if the pattern has the prefix "!"
+	dirOnly bool // if the pattern has the suffix "/"
+}
+
+// GitIgnoreFilter is a filter to exclude the files ignored by Git.
+// It reads .git/info/exclude and .gitignore in each directory of the working tree,
+// and the rules in a deeper directory take precedence.
+type GitIgnoreFilter struct {
+	root    string                     // absolute path to the working tree
+	exclude []gitIgnoreRule            // .git/info/exclude
+	rules   map[string][]gitIgnoreRule // .gitignore by the slash-separated directory relative to root
+}
+
+// NewGitIgnoreFilter returns a filter of .gitignore.
+// It finds the working tree from the current directory to the root.
+// If no working tree is found, the current directory is used.
+func (fs *FileSystem) NewGitIgnoreFilter() (FindFilesFilter, error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("error while getting the current directory: %w", err)
+	}
+	root := findWorkingTree(wd)
+	slog.Debug("Using .gitignore in the working tree", "root", root)
+	exclude, err := readGitIgnoreRules(filepath.Join(root, ".git", "info", "exclude"))
+	if err != nil {
+		return nil, err
+	}
+	return &GitIgnoreFilter{
+		root:    root,
+		exclude: exclude,
+		rules:   make(map[string][]gitIgnoreRule),
+	}, nil
+}
+
+func findWorkingTree(dir string) string {
+	for d := dir; ; d = filepath.Dir(d) {
+		if _, err := os.Stat(filepath.Join(d, ".git")); err == nil {
+			return d
+		}
+		if filepath.Dir(d) == d {
+			return dir
+		}
+	}
+}
+
+func (f *GitIgnoreFilter) SkipDir(path string) bool {
+	if f.isIgnored(path, true) {
+		slog.Debug("Exclude the directory ignored by .gitignore", "path", path)
+		return true
+	}
+	return false
+}
+
+func (f *GitIgnoreFilter) ExcludeFile(path string) bool {
+	if f.isIgnored(path, false) {
+		slog.Debug("Exclude the file ignored by .gitignore", "path", path)
+		return true
+	}
+	return false
+}
+
+func (f *GitIgnoreFilter) isIgnored(p string, isDir bool) bool {
+	abs, err := filepath.Abs(p)
+	if err != nil {
+		return false
+	}
+	rel, err := filepath.Rel(f.root, abs)
+	if err != nil {
+		return false
+	}
+	rel = filepath.ToSlash(rel)
+	if rel == "." || rel == ".." || strings.HasPrefix(rel, "../") {
+		return false
+	}
+	// a file is ignored if any parent directory is ignored
+	elems := strings.Split(rel, "/")
+	for i := 1; i < len(elems); i++ {
+		if f.match(elems[:i], true) {
+			return true
+		}
+	}
+	return f.match(elems, isDir)
+}
+
+func (f *GitIgnoreFilter) match(elems []string, isDir bool) bool {
+	name := strings.Join(elems, "/")
+	ignored := matchGitIgnoreRules(f.exclude, name, isDir, false)
+	for i := range elems {
+		dir := path.Join(elems[:i]...)
+		if dir == "" {
+			dir = "."
+		}
+		ignored = matchGitIgnoreRules(f.loadRules(dir), strings.Join(elems[i:], "/"), isDir, ignored)
+	}
+	return ignored
+}
+
+// loadRules returns the rules of .gitignore in the directory.
+func (f *GitIgnoreFilter) loadRules(dir string) []gitIgnoreRule {
+	if rules, ok := f.rules[dir]; ok {
+		return rules
+	}
+	rules, err := readGitIgnoreRules(filepath.Join(f.root, filepath.FromSlash(dir), ".gitignore"))
+	if err != nil {
+		slog.Warn("Ignored the invalid .gitignore", "error", err)
+	}
+	f.rules[dir] = rules
+	return rules
+}
+
+// matchGitIgnoreRules returns true if the name is ignored by the rules.
+// The name must be relative to the directory of the rules.
+// If no rule is matched, it returns the given value.
+func matchGitIgnoreRules(rules []gitIgnoreRule, name string, isDir bool, ignored bool) bool {
+	for _, rule := range rules {
+		if rule.dirOnly && !isDir {
+			continue
+		}
+		if matchPattern(rule.pattern, name) {
+			ignored = !rule.negate
+		}
+	}
+	return ignored
+}
+
+// readGitIgnoreRules returns the rules in the file.
+// It returns nil if the file does not exist.
+func readGitIgnoreRules(filename string) ([]gitIgnoreRule, error) {
+	r, err := os.Open(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error while opening file %s: %w", filename, err)
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			slog.Error("Failed to close the file", "error", err)
+		}
+	}()
+	rules, err := parseGitIgnoreRules(r)
+	if err != nil {
+		return nil, fmt.Errorf("error while reading file %s: %w", filename, err)
+	}
+	return rules, nil
+}
+
+// parseGitIgnoreRules parses the content in the format of gitignore.
+// https://git-scm.com/docs/gitignore#_pattern_format
+func parseGitIgnoreRules(r io.Reader) ([]gitIgnoreRule, error) {
+	var rules []gitIgnoreRule
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		// trailing spaces are ignored unless they are escaped
+		trimmed := strings.TrimRight(line, " ")
+		if strings.HasSuffix(trimmed, `\`) && len(trimmed) < len(line) {
+			trimmed += " "
+		}
+		var rule gitIgnoreRule
+		if strings.HasPrefix(trimmed, "!") {
+			rule.negate = true
+			trimmed = trimmed[1:]
+		}
+		if strings.HasSuffix(trimmed, "/") {
+			rule.dirOnly = true
+			trimmed = strings.TrimSuffix(trimmed, "/")
+		}
+		if trimmed == "" {
+			continue
+		}
+		rule.pattern = trimmed
+		rules = append(rules, rule)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
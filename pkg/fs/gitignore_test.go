@@ -0,0 +1,81 @@
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFileSystem_NewGitIgnoreFilter(t *testing.T) {
+	fs := &FileSystem{}
+	tempDir := t.TempDir()
+	for name, content := range map[string]string{
+		".git/info/exclude":           "*.swp\n",
+		".gitignore":                  "# comment\nnode_modules/\n/build\n*.log\n!important.log\ndocs/**/*.tmp\n",
+		"app/.gitignore":              "local/\n!keep.swp\n",
+		"app/main.go":                 "",
+		"app/main.go.swp":             "",
+		"app/keep.swp":                "",
+		"app/debug.log":               "",
+		"app/important.log":           "",
+		"app/local/config":            "",
+		"app/build/output":            "",
+		"app/node_modules/a/index.js": "",
+		"build/output":                "",
+		"docs/a/b/c.tmp":              "",
+		"docs/a/b/c.md":               "",
+		"node_modules":                "", // not a directory
+	} {
+		filename := filepath.Join(tempDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Chdir(filepath.Join(tempDir, "app"))
+
+	filter, err := fs.NewGitIgnoreFilter()
+	if err != nil {
+		t.Fatalf("NewGitIgnoreFilter returned error: %+v", err)
+	}
+	got, err := fs.FindFiles([]string{".", "../build", "../docs", "../node_modules", "../.gitignore"}, filter)
+	if err != nil {
+		t.Fatalf("FindFiles returned error: %+v", err)
+	}
+	want := []File{
+		{Path: ".gitignore", Size: 17},
+		{Path: "build/output"},
+		{Path: "important.log"},
+		{Path: "keep.swp"},
+		{Path: "main.go"},
+		{Path: "../docs/a/b/c.md"},
+		{Path: "../node_modules"},
+		{Path: "../.gitignore", Size: 66},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_parseGitIgnoreRules(t *testing.T) {
+	rules, err := parseGitIgnoreRules(strings.NewReader("# comment\n\n*.o\n!keep.o\ntmp/\ntrailing  \nescaped\\ \n\\#hash\n!\n"))
+	if err != nil {
+		t.Fatalf("parseGitIgnoreRules returned error: %+v", err)
+	}
+	want := []gitIgnoreRule{
+		{pattern: "*.o"},
+		{pattern: "keep.o", negate: true},
+		{pattern: "tmp", dirOnly: true},
+		{pattern: "trailing"},
+		{pattern: "escaped\\ "},
+		{pattern: "\\#hash"},
+	}
+	if diff := cmp.Diff(want, rules, cmp.AllowUnexported(gitIgnoreRule{})); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
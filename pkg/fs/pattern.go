@@ -0,0 +1,47 @@
+package fs
+
+import (
+	"path"
+	"strings"
+)
+
+// matchPattern returns true if the slash-separated path matches the pattern
+// in the format of .gitignore and .gitattributes.
+//
+// If the pattern contains a slash at the beginning or middle, it is relative to the root.
+// Otherwise, it matches the name at any level.
+// A leading "**/" matches in all directories, a trailing "/**" matches everything inside,
+// and "/**/" matches zero or more directories.
+func matchPattern(pattern, name string) bool {
+	anchored := strings.Contains(strings.TrimSuffix(pattern, "/"), "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+	if !anchored {
+		pattern = "**/" + pattern
+	}
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func matchSegments(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			rest := pattern[1:]
+			if len(rest) == 0 {
+				return len(name) > 0
+			}
+			for i := range len(name) + 1 {
+				if matchSegments(rest, name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(name) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pattern[0], name[0]); !ok {
+			return false
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+	return len(name) == 0
+}
@@ -0,0 +1,34 @@
+package fs
+
+import "testing"
+
+func Test_matchPattern(t *testing.T) {
+	for _, c := range []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{"*.bin", "a.bin", true},
+		{"*.bin", "dir/a.bin", true},
+		{"*.bin", "a.txt", false},
+		{"a?.bin", "ab.bin", true},
+		{"[ab].bin", "c.bin", false},
+		{"/a.bin", "a.bin", true},
+		{"/a.bin", "dir/a.bin", false},
+		{"dir/*.bin", "dir/a.bin", true},
+		{"dir/*.bin", "dir/sub/a.bin", false},
+		{"dir/*.bin", "other/dir/a.bin", false},
+		{"**/dir/*.bin", "other/dir/a.bin", true},
+		{"dir/**", "dir/sub/a.bin", true},
+		{"dir/**", "dir", false},
+		{"dir/**/a.bin", "dir/a.bin", true},
+		{"dir/**/a.bin", "dir/x/y/a.bin", true},
+		{"dir/**/a.bin", "other/a.bin", false},
+	} {
+		t.Run(c.pattern+" "+c.name, func(t *testing.T) {
+			if got := matchPattern(c.pattern, c.name); got != c.want {
+				t.Errorf("matchPattern(%q, %q) wants %v but %v", c.pattern, c.name, c.want, got)
+			}
+		})
+	}
+}
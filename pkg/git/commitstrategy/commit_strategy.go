@@ -9,8 +9,12 @@ import (
 // CommitStrategy represents a method to create a commit object.
 type CommitStrategy interface {
 	IsFastForward() bool
+	KeepLast() int
 	IsRebase() bool
 	RebaseUpstream() git.RefName
+	IsMerge() bool
+	MergeRef() git.RefName
+	MergeSide() MergeSide
 	NoParent() bool
 	String() string
 }
@@ -18,19 +22,31 @@ type CommitStrategy interface {
 type commitStrategy struct {
 	name        string
 	fastForward bool
+	keepLast    int
 	rebase      bool
+	merge       bool
 	noParent    bool
 }
 
 func (s *commitStrategy) IsFastForward() bool         { return s.fastForward }
+func (s *commitStrategy) KeepLast() int               { return s.keepLast }
 func (s *commitStrategy) IsRebase() bool              { return s.rebase }
 func (s *commitStrategy) RebaseUpstream() git.RefName { return "" }
+func (s *commitStrategy) IsMerge() bool               { return s.merge }
+func (s *commitStrategy) MergeRef() git.RefName       { return "" }
+func (s *commitStrategy) MergeSide() MergeSide        { return "" }
 func (s *commitStrategy) NoParent() bool              { return s.noParent }
 func (s *commitStrategy) String() string              { return s.name }
 
 // FastForward represents the fast-forward.
 var FastForward CommitStrategy = &commitStrategy{name: "fast-forward", fastForward: true}
 
+// FastForwardKeepLast represents the fast-forward which rewrites the branch to keep only the last n commits,
+// including the new commit. The other commits are recreated on a new root.
+func FastForwardKeepLast(n int) CommitStrategy {
+	return &commitStrategy{name: fmt.Sprintf("fast-forward-keep-last-%d", n), fastForward: true, keepLast: n}
+}
+
 // NoParent represents the method to create a commit without any parent
 var NoParent CommitStrategy = &commitStrategy{name: "no-parent", noParent: true}
 
@@ -48,3 +64,30 @@ type rebase struct {
 }
 
 func (f *rebase) RebaseUpstream() git.RefName { return f.upstreamRef }
+
+// MergeSide represents the tree which the files are applied onto in a merge commit.
+type MergeSide string
+
+const (
+	Ours   MergeSide = "ours"   // tree of the target branch
+	Theirs MergeSide = "theirs" // tree of the merged ref
+)
+
+// MergeWith represents the merge commit of the target branch and the ref.
+// The first parent is the target branch and the second parent is the ref.
+func MergeWith(ref git.RefName, side MergeSide) CommitStrategy {
+	return &merge{
+		commitStrategy: commitStrategy{name: fmt.Sprintf("merge-with-%v", ref), merge: true},
+		ref:            ref,
+		side:           side,
+	}
+}
+
+type merge struct {
+	commitStrategy
+	ref  git.RefName
+	side MergeSide
+}
+
+func (f *merge) MergeRef() git.RefName { return f.ref }
+func (f *merge) MergeSide() MergeSide  { return f.side }
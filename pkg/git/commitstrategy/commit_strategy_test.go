@@ -18,3 +18,40 @@ func TestRebaseOn(t *testing.T) {
 		t.Errorf("NoParent wants false but got true")
 	}
 }
+
+func TestMergeWith(t *testing.T) {
+	s := MergeWith("main", Theirs)
+
+	if s.MergeRef() != "main" {
+		t.Errorf("MergeRef wants %s but got %s", "main", s.MergeRef())
+	}
+	if s.MergeSide() != Theirs {
+		t.Errorf("MergeSide wants %s but got %s", Theirs, s.MergeSide())
+	}
+	if !s.IsMerge() {
+		t.Errorf("IsMerge wants true but got false")
+	}
+	if s.IsFastForward() {
+		t.Errorf("IsFastForward wants false but got true")
+	}
+	if s.IsRebase() {
+		t.Errorf("IsRebase wants false but got true")
+	}
+	if s.RebaseUpstream() != "" {
+		t.Errorf("RebaseUpstream wants empty but got %s", s.RebaseUpstream())
+	}
+}
+
+func TestFastForwardKeepLast(t *testing.T) {
+	s := FastForwardKeepLast(3)
+
+	if s.KeepLast() != 3 {
+		t.Errorf("KeepLast wants %d but got %d", 3, s.KeepLast())
+	}
+	if !s.IsFastForward() {
+		t.Errorf("IsFastForward wants true but got false")
+	}
+	if FastForward.KeepLast() != 0 {
+		t.Errorf("KeepLast of FastForward wants 0 but got %d", FastForward.KeepLast())
+	}
+}
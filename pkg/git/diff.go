@@ -0,0 +1,33 @@
+package git
+
+import (
+	"slices"
+	"strings"
+)
+
+// DiffTrees returns the files changed from the base tree to the head tree, sorted by the filename.
+// A file which does not exist in the head tree is returned as deleted.
+func DiffTrees(base, head Tree) []File {
+	baseFiles := make(map[string]File, len(base.Files))
+	for _, file := range base.Files {
+		baseFiles[file.Filename] = file
+	}
+	headFiles := make(map[string]File, len(head.Files))
+	for _, file := range head.Files {
+		headFiles[file.Filename] = file
+	}
+	var changes []File
+	for _, file := range head.Files {
+		if baseFile, exists := baseFiles[file.Filename]; exists && baseFile == file {
+			continue
+		}
+		changes = append(changes, file)
+	}
+	for _, file := range base.Files {
+		if _, exists := headFiles[file.Filename]; !exists {
+			changes = append(changes, File{Filename: file.Filename, Deleted: true})
+		}
+	}
+	slices.SortFunc(changes, func(a, b File) int { return strings.Compare(a.Filename, b.Filename) })
+	return changes
+}
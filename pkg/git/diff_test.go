@@ -0,0 +1,38 @@
+package git
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDiffTrees(t *testing.T) {
+	base := Tree{
+		SHA: "baseTreeSHA",
+		Files: []File{
+			{Filename: "same", BlobSHA: "blobSHA1"},
+			{Filename: "modified", BlobSHA: "blobSHA2"},
+			{Filename: "mode", BlobSHA: "blobSHA3"},
+			{Filename: "deleted", BlobSHA: "blobSHA4"},
+		},
+	}
+	head := Tree{
+		SHA: "headTreeSHA",
+		Files: []File{
+			{Filename: "same", BlobSHA: "blobSHA1"},
+			{Filename: "modified", BlobSHA: "blobSHA5"},
+			{Filename: "mode", BlobSHA: "blobSHA3", Executable: true},
+			{Filename: "added", BlobSHA: "blobSHA6"},
+		},
+	}
+	got := DiffTrees(base, head)
+	want := []File{
+		{Filename: "added", BlobSHA: "blobSHA6"},
+		{Filename: "deleted", Deleted: true},
+		{Filename: "mode", BlobSHA: "blobSHA3", Executable: true},
+		{Filename: "modified", BlobSHA: "blobSHA5"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
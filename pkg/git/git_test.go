@@ -57,3 +57,33 @@ func TestRefQualifiedName_String(t *testing.T) {
 		})
 	}
 }
+
+func TestParseRemoteURL(t *testing.T) {
+	for s, wantHost := range map[string]string{
+		"https://github.com/owner/repo.git":          "github.com",
+		"https://user@github.com/owner/repo":         "github.com",
+		"ssh://git@ghe.example.com:2222/owner/repo/": "ghe.example.com",
+		"git@github.com:owner/repo.git":              "github.com",
+		"github.com:owner/repo":                      "github.com",
+	} {
+		t.Run(s, func(t *testing.T) {
+			host, id, err := ParseRemoteURL(s)
+			if err != nil {
+				t.Fatalf("ParseRemoteURL returned error: %+v", err)
+			}
+			if host != wantHost {
+				t.Errorf("host wants %s but %s", wantHost, host)
+			}
+			if want := (RepositoryID{Owner: "owner", Name: "repo"}); id != want {
+				t.Errorf("id wants %+v but %+v", want, id)
+			}
+		})
+	}
+	for _, s := range []string{"/path/to/repo", "file:///path/to/repo", "https://github.com/owner", "git@github.com:owner/repo/sub"} {
+		t.Run(s, func(t *testing.T) {
+			if _, _, err := ParseRemoteURL(s); err == nil {
+				t.Errorf("err wants non-nil but nil")
+			}
+		})
+	}
+}
@@ -1,5 +1,10 @@
 package git
 
+import (
+	"io"
+	"time"
+)
+
 // CommitSHA represents a pointer to a commit.
 type CommitSHA string
 
@@ -13,13 +18,35 @@ type NewCommit struct {
 	Author          *CommitAuthor // optional
 	Committer       *CommitAuthor // optional
 	ParentCommitSHA CommitSHA     // optional
+	MergeParentSHAs []CommitSHA   // parents after the first one to create a merge commit (optional)
 	TreeSHA         TreeSHA
+	Signature       string // armored signature of Payload (optional)
+}
+
+// Parents returns the parents of the commit in order.
+func (c NewCommit) Parents() []CommitSHA {
+	var parents []CommitSHA
+	if c.ParentCommitSHA != "" {
+		parents = append(parents, c.ParentCommitSHA)
+	}
+	return append(parents, c.MergeParentSHAs...)
+}
+
+// Commit represents an existing commit.
+type Commit struct {
+	SHA       CommitSHA
+	Message   CommitMessage
+	Author    CommitAuthor
+	Committer CommitAuthor
+	Parents   []CommitSHA
+	TreeSHA   TreeSHA
 }
 
 // CommitAuthor represents an author of commit.
 type CommitAuthor struct {
 	Name  string
 	Email string
+	Date  time.Time // optional
 }
 
 // TreeSHA represents a pointer to a tree.
@@ -30,6 +57,7 @@ type File struct {
 	Filename   string  // filename (including path separators)
 	BlobSHA    BlobSHA // blob SHA
 	Executable bool    // if the file is executable
+	Deleted    bool    // if the file is deleted from the tree
 }
 
 // Mode returns mode of the file, i.e. 100644 or 100755.
@@ -40,6 +68,12 @@ func (f *File) Mode() string {
 	return "100644"
 }
 
+// Tree represents an existing tree.
+type Tree struct {
+	SHA   TreeSHA
+	Files []File // regular files in the tree, including subdirectories
+}
+
 // NewTree represents a tree.
 type NewTree struct {
 	Repository  RepositoryID
@@ -53,5 +87,6 @@ type BlobSHA string
 // NewBlob represents a blob.
 type NewBlob struct {
 	Repository RepositoryID
-	Content    string // base64 encoded content
+	Content    io.Reader // raw content
+	Size       int64     // length of the content
 }
@@ -0,0 +1,27 @@
+package git
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+)
+
+// LFSObject represents an object of Git LFS.
+type LFSObject struct {
+	OID  string // SHA-256 of the content
+	Size int64
+}
+
+// Pointer returns the content of the pointer file.
+// https://github.com/git-lfs/git-lfs/blob/main/docs/spec.md
+func (o LFSObject) Pointer() string {
+	return fmt.Sprintf("version https://git-lfs.github.com/spec/v1\noid sha256:%s\nsize %d\n", o.OID, o.Size)
+}
+
+// PointerBlobSHA returns SHA of the blob of the pointer file.
+func (o LFSObject) PointerBlobSHA() BlobSHA {
+	pointer := o.Pointer()
+	h := sha1.New()
+	_, _ = fmt.Fprintf(h, "blob %d\x00%s", len(pointer), pointer)
+	return BlobSHA(hex.EncodeToString(h.Sum(nil)))
+}
@@ -0,0 +1,20 @@
+package git
+
+import (
+	"testing"
+)
+
+func TestLFSObject_Pointer(t *testing.T) {
+	o := LFSObject{OID: "4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393", Size: 12345}
+	want := `version https://git-lfs.github.com/spec/v1
+oid sha256:4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393
+size 12345
+`
+	if pointer := o.Pointer(); pointer != want {
+		t.Errorf("Pointer wants %s but %s", want, pointer)
+	}
+	// same as git hash-object
+	if want := BlobSHA("60c8d8ab2adcf57a391163a7eeb0cdb8bf348e44"); o.PointerBlobSHA() != want {
+		t.Errorf("PointerBlobSHA wants %s but %s", want, o.PointerBlobSHA())
+	}
+}
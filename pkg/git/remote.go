@@ -0,0 +1,38 @@
+package git
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ParseRemoteURL returns the host and repository of the URL of a remote.
+// It supports the forms of https://HOST/OWNER/REPO, ssh://git@HOST/OWNER/REPO and git@HOST:OWNER/REPO,
+// with or without the suffix .git.
+func ParseRemoteURL(s string) (string, RepositoryID, error) {
+	var host, p string
+	if u, err := url.Parse(s); err == nil && u.Scheme != "" && u.Host != "" {
+		switch u.Scheme {
+		case "https", "http", "ssh", "git":
+		default:
+			return "", RepositoryID{}, fmt.Errorf("unsupported scheme %s of the remote URL", u.Scheme)
+		}
+		host, p = u.Hostname(), u.Path
+	} else {
+		// scp-like syntax, e.g., git@github.com:owner/repo.git
+		hostPart, pathPart, ok := strings.Cut(s, ":")
+		if !ok || strings.Contains(hostPart, "/") {
+			return "", RepositoryID{}, fmt.Errorf("unsupported remote URL %s", s)
+		}
+		if _, h, ok := strings.Cut(hostPart, "@"); ok {
+			hostPart = h
+		}
+		host, p = hostPart, pathPart
+	}
+	p = strings.TrimSuffix(strings.Trim(p, "/"), ".git")
+	owner, name, ok := strings.Cut(p, "/")
+	if !ok || owner == "" || name == "" || strings.Contains(name, "/") {
+		return "", RepositoryID{}, fmt.Errorf("remote URL %s must point to OWNER/REPO", s)
+	}
+	return strings.ToLower(host), RepositoryID{Owner: owner, Name: name}, nil
+}
@@ -0,0 +1,76 @@
+package git
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// SignatureFormat represents a format of the commit signature.
+type SignatureFormat string
+
+const (
+	SignatureFormatOpenPGP SignatureFormat = "openpgp"
+	SignatureFormatSSH     SignatureFormat = "ssh"
+)
+
+// SigningKey represents a key to sign a commit.
+type SigningKey struct {
+	Format SignatureFormat
+	Key    string // key ID for OpenPGP, or path to the key file for SSH
+}
+
+// Payload returns the raw commit object without the signature.
+// This is the content to be signed.
+// The author and committer must be set with the dates.
+func (c NewCommit) Payload() ([]byte, error) {
+	return c.object(false)
+}
+
+// SHA returns the hash of the raw commit object including the signature.
+// It must be same as the commit created by GitHub.
+func (c NewCommit) SHA() (CommitSHA, error) {
+	object, err := c.object(true)
+	if err != nil {
+		return "", err
+	}
+	h := sha1.New()
+	_, _ = fmt.Fprintf(h, "commit %d\x00", len(object))
+	_, _ = h.Write(object)
+	return CommitSHA(fmt.Sprintf("%x", h.Sum(nil))), nil
+}
+
+// object returns the raw commit object in the format of Git.
+// https://git-scm.com/book/en/v2/Git-Internals-Git-Objects
+func (c NewCommit) object(withSignature bool) ([]byte, error) {
+	if c.TreeSHA == "" {
+		return nil, errors.New("tree is required")
+	}
+	if c.Author == nil || c.Author.Date.IsZero() {
+		return nil, errors.New("author and date are required")
+	}
+	if c.Committer == nil || c.Committer.Date.IsZero() {
+		return nil, errors.New("committer and date are required")
+	}
+	var b bytes.Buffer
+	_, _ = fmt.Fprintf(&b, "tree %s\n", c.TreeSHA)
+	for _, parent := range c.Parents() {
+		_, _ = fmt.Fprintf(&b, "parent %s\n", parent)
+	}
+	_, _ = fmt.Fprintf(&b, "author %s\n", c.Author.identity())
+	_, _ = fmt.Fprintf(&b, "committer %s\n", c.Committer.identity())
+	if withSignature && c.Signature != "" {
+		// continuation lines of a header are indented by a space
+		signature := strings.TrimSuffix(c.Signature, "\n")
+		_, _ = fmt.Fprintf(&b, "gpgsig %s\n", strings.ReplaceAll(signature, "\n", "\n "))
+	}
+	_, _ = fmt.Fprintf(&b, "\n%s", c.Message)
+	return b.Bytes(), nil
+}
+
+// identity returns the identity in form of "NAME <EMAIL> UNIX_TIME TIMEZONE".
+func (a CommitAuthor) identity() string {
+	return fmt.Sprintf("%s <%s> %d %s", a.Name, a.Email, a.Date.Unix(), a.Date.Format("-0700"))
+}
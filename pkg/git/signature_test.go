@@ -0,0 +1,107 @@
+package git
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewCommit_SHA(t *testing.T) {
+	t.Run("NoParent", func(t *testing.T) {
+		date := time.Date(2026, 1, 2, 3, 4, 5, 0, time.FixedZone("", 9*60*60))
+		c := NewCommit{
+			Message:   "first\n",
+			Author:    &CommitAuthor{Name: "Alice", Email: "alice@example.com", Date: date},
+			Committer: &CommitAuthor{Name: "Alice", Email: "alice@example.com", Date: date},
+			TreeSHA:   "c49897f29f9819a0ab6850d7e22443508a1a29d5",
+		}
+		sha, err := c.SHA()
+		if err != nil {
+			t.Fatalf("SHA returned error: %+v", err)
+		}
+		// same as git commit
+		if want := CommitSHA("941c179e1e851c1d0ac5cb642450e80315b16740"); sha != want {
+			t.Errorf("SHA wants %s but %s", want, sha)
+		}
+	})
+
+	t.Run("MergeCommit", func(t *testing.T) {
+		date := time.Date(2026, 1, 2, 3, 4, 5, 0, time.FixedZone("", 9*60*60))
+		c := NewCommit{
+			Message:         "merge\n",
+			Author:          &CommitAuthor{Name: "Alice", Email: "alice@example.com", Date: date},
+			Committer:       &CommitAuthor{Name: "Alice", Email: "alice@example.com", Date: date},
+			ParentCommitSHA: "563ec2f39dec363edde3ee5c9d1c0d3dfa69ef34",
+			MergeParentSHAs: []CommitSHA{"2229be7fcac5571c72db6bc062641c7cec7854fd"},
+			TreeSHA:         "4b825dc642cb6eb9a060e54bf8d69288fbee4904",
+		}
+		sha, err := c.SHA()
+		if err != nil {
+			t.Fatalf("SHA returned error: %+v", err)
+		}
+		// same as git commit-tree -p PARENT1 -p PARENT2
+		if want := CommitSHA("1e5d623582ee07270407c43a54584a1d3f8aafd4"); sha != want {
+			t.Errorf("SHA wants %s but %s", want, sha)
+		}
+	})
+
+	t.Run("Signature", func(t *testing.T) {
+		c := NewCommit{
+			Message: "second\n\nbody\n",
+			Author: &CommitAuthor{
+				Name:  "Bob",
+				Email: "bob@example.com",
+				Date:  time.Date(2026, 1, 2, 3, 4, 5, 0, time.FixedZone("", 9*60*60)),
+			},
+			Committer: &CommitAuthor{
+				Name:  "Bob",
+				Email: "bob@example.com",
+				Date:  time.Date(2026, 1, 3, 0, 0, 0, 0, time.FixedZone("", -5*60*60)),
+			},
+			ParentCommitSHA: "941c179e1e851c1d0ac5cb642450e80315b16740",
+			TreeSHA:         "cee8a0626ef85421b9be0ce2ad448a6689a24de3",
+			Signature: `-----BEGIN SSH SIGNATURE-----
+U1NIU0lHAAAAAQAAADMAAAALc3NoLWVkMjU1MTkAAAAgyMH4hE5gwBfzEYabfy6J0Bqkbd
+Cp294dTr3mkvX9RN0AAAADZ2l0AAAAAAAAAAZzaGE1MTIAAABTAAAAC3NzaC1lZDI1NTE5
+AAAAQKbQMqxxT5AzH4XRzqOd5XgEqQakzgkgfDFtkYwKNhJHJX1Bn+674la+vklboBh+Zq
+OMKJ+7HNqR2lrp1Lq49Ag=
+-----END SSH SIGNATURE-----
+`,
+		}
+		payload, err := c.Payload()
+		if err != nil {
+			t.Fatalf("Payload returned error: %+v", err)
+		}
+		wantPayload := `tree cee8a0626ef85421b9be0ce2ad448a6689a24de3
+parent 941c179e1e851c1d0ac5cb642450e80315b16740
+author Bob <bob@example.com> 1767290645 +0900
+committer Bob <bob@example.com> 1767416400 -0500
+
+second
+
+body
+`
+		if string(payload) != wantPayload {
+			t.Errorf("Payload wants %s but %s", wantPayload, payload)
+		}
+		sha, err := c.SHA()
+		if err != nil {
+			t.Fatalf("SHA returned error: %+v", err)
+		}
+		// same as git commit -S
+		if want := CommitSHA("ca0d69410fef24a099acb1bb954516ea6718b198"); sha != want {
+			t.Errorf("SHA wants %s but %s", want, sha)
+		}
+	})
+
+	t.Run("NoDate", func(t *testing.T) {
+		c := NewCommit{
+			Message:   "message",
+			Author:    &CommitAuthor{Name: "Alice", Email: "alice@example.com"},
+			Committer: &CommitAuthor{Name: "Alice", Email: "alice@example.com"},
+			TreeSHA:   "treeSHA",
+		}
+		if _, err := c.SHA(); err == nil {
+			t.Errorf("err wants non-nil but nil")
+		}
+	})
+}
@@ -0,0 +1,112 @@
+package git
+
+import (
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+)
+
+// Trailer represents a trailer of a commit message, such as Signed-off-by.
+// See https://git-scm.com/docs/git-interpret-trailers
+type Trailer struct {
+	Key   string
+	Value string
+}
+
+func (t Trailer) String() string {
+	return t.Key + ": " + t.Value
+}
+
+var trailerKeyPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9-]*$`)
+
+// ParseTrailer parses a trailer in form of KEY=VALUE.
+func ParseTrailer(s string) (Trailer, error) {
+	key, value, ok := strings.Cut(s, "=")
+	if !ok {
+		return Trailer{}, fmt.Errorf("trailer must be in form of KEY=VALUE but was %s", s)
+	}
+	key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+	if !trailerKeyPattern.MatchString(key) {
+		return Trailer{}, fmt.Errorf("trailer key must be alphanumeric or hyphen but was %s", key)
+	}
+	if value == "" || strings.Contains(value, "\n") {
+		return Trailer{}, fmt.Errorf("trailer value must be a non-empty single line")
+	}
+	return Trailer{Key: key, Value: value}, nil
+}
+
+// CoAuthoredBy returns a Co-authored-by trailer of the person in form of "Name <email>".
+func CoAuthoredBy(person string) (Trailer, error) {
+	addr, err := mail.ParseAddress(person)
+	if err != nil || addr.Name == "" {
+		return Trailer{}, fmt.Errorf("co-author must be in form of Name <email> but was %s", person)
+	}
+	return Trailer{Key: "Co-authored-by", Value: fmt.Sprintf("%s <%s>", addr.Name, addr.Address)}, nil
+}
+
+// SignedOffBy returns a Signed-off-by trailer of the person.
+func SignedOffBy(a CommitAuthor) Trailer {
+	return Trailer{Key: "Signed-off-by", Value: fmt.Sprintf("%s <%s>", a.Name, a.Email)}
+}
+
+var trailerLinePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9-]*: `)
+
+// WithTrailers returns the message with the trailers.
+// If the last paragraph of the message consists of trailers, they are appended to it.
+// A trailer is not added if the same one already exists.
+func (m CommitMessage) WithTrailers(trailers ...Trailer) CommitMessage {
+	if len(trailers) == 0 {
+		return m
+	}
+	message := strings.TrimRight(string(m), "\n")
+	var existing []string
+	hasTrailerBlock := false
+	if i := strings.LastIndex(message, "\n\n"); i >= 0 {
+		lastParagraph := strings.Split(message[i+2:], "\n")
+		hasTrailerBlock = true
+		for _, line := range lastParagraph {
+			if !trailerLinePattern.MatchString(line) {
+				hasTrailerBlock = false
+				break
+			}
+		}
+		if hasTrailerBlock {
+			existing = lastParagraph
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString(message)
+	if hasTrailerBlock {
+		b.WriteString("\n")
+	} else {
+		b.WriteString("\n\n")
+	}
+	var added []string
+	for _, trailer := range trailers {
+		line := trailer.String()
+		if containsTrailer(existing, line) || containsTrailer(added, line) {
+			continue
+		}
+		added = append(added, line)
+	}
+	if len(added) == 0 {
+		return m
+	}
+	b.WriteString(strings.Join(added, "\n"))
+	return CommitMessage(b.String())
+}
+
+// containsTrailer returns true if the lines contain the trailer.
+// The keys are compared case-insensitively as well as Git.
+func containsTrailer(lines []string, trailer string) bool {
+	key, value, _ := strings.Cut(trailer, ": ")
+	for _, line := range lines {
+		k, v, _ := strings.Cut(line, ": ")
+		if strings.EqualFold(k, key) && strings.TrimSpace(v) == value {
+			return true
+		}
+	}
+	return false
+}
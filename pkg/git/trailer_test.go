@@ -0,0 +1,84 @@
+package git
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCommitMessage_WithTrailers(t *testing.T) {
+	signedOffBy := Trailer{Key: "Signed-off-by", Value: "Alice <alice@example.com>"}
+	coAuthoredBy := Trailer{Key: "Co-authored-by", Value: "Bob <bob@example.com>"}
+	for _, c := range []struct {
+		name    string
+		message CommitMessage
+		want    CommitMessage
+	}{
+		{
+			name:    "Subject",
+			message: "subject\n",
+			want:    "subject\n\nSigned-off-by: Alice <alice@example.com>\nCo-authored-by: Bob <bob@example.com>",
+		},
+		{
+			name:    "Body",
+			message: "subject\n\nbody",
+			want:    "subject\n\nbody\n\nSigned-off-by: Alice <alice@example.com>\nCo-authored-by: Bob <bob@example.com>",
+		},
+		{
+			name:    "ExistingTrailers",
+			message: "subject\n\nbody\n\nReviewed-by: Carol <carol@example.com>",
+			want:    "subject\n\nbody\n\nReviewed-by: Carol <carol@example.com>\nSigned-off-by: Alice <alice@example.com>\nCo-authored-by: Bob <bob@example.com>",
+		},
+		{
+			name:    "DuplicatedTrailer",
+			message: "subject\n\nco-authored-by: Bob <bob@example.com>",
+			want:    "subject\n\nco-authored-by: Bob <bob@example.com>\nSigned-off-by: Alice <alice@example.com>",
+		},
+		{
+			name:    "AllDuplicated",
+			message: "subject\n\nSigned-off-by: Alice <alice@example.com>\nCo-authored-by: Bob <bob@example.com>",
+			want:    "subject\n\nSigned-off-by: Alice <alice@example.com>\nCo-authored-by: Bob <bob@example.com>",
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			got := c.message.WithTrailers(signedOffBy, coAuthoredBy, signedOffBy)
+			if diff := cmp.Diff(c.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseTrailer(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		got, err := ParseTrailer("Reviewed-by=Carol <carol@example.com>")
+		if err != nil {
+			t.Fatalf("ParseTrailer returned error: %+v", err)
+		}
+		want := Trailer{Key: "Reviewed-by", Value: "Carol <carol@example.com>"}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+	for _, s := range []string{"Reviewed-by", "Reviewed by=Carol", "Reviewed-by="} {
+		t.Run(s, func(t *testing.T) {
+			if _, err := ParseTrailer(s); err == nil {
+				t.Errorf("err wants non-nil but nil")
+			}
+		})
+	}
+}
+
+func TestCoAuthoredBy(t *testing.T) {
+	got, err := CoAuthoredBy("Bob <bob@example.com>")
+	if err != nil {
+		t.Fatalf("CoAuthoredBy returned error: %+v", err)
+	}
+	want := Trailer{Key: "Co-authored-by", Value: "Bob <bob@example.com>"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+	if _, err := CoAuthoredBy("bob@example.com"); err == nil {
+		t.Errorf("err wants non-nil but nil")
+	}
+}
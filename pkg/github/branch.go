@@ -2,8 +2,11 @@ package github
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 
 	"github.com/int128/ghcp/pkg/git"
 	"github.com/shurcooL/githubv4"
@@ -18,6 +21,7 @@ type QueryForCommitInput struct {
 
 type QueryForCommitOutput struct {
 	CurrentUserName              string
+	CurrentUser                  git.CommitAuthor // name and email of the current user, without the date
 	ParentDefaultBranchCommitSHA git.CommitSHA
 	ParentDefaultBranchTreeSHA   git.TreeSHA
 	ParentRefCommitSHA           git.CommitSHA // empty if the parent ref does not exist
@@ -36,7 +40,10 @@ func (q *QueryForCommitOutput) TargetBranchExists() bool {
 func (c *GitHub) QueryForCommit(ctx context.Context, in QueryForCommitInput) (*QueryForCommitOutput, error) {
 	var q struct {
 		Viewer struct {
-			Login string
+			Login      string
+			Name       string
+			Email      string
+			DatabaseID int64 `graphql:"databaseId"`
 		}
 
 		ParentRepository struct {
@@ -98,6 +105,7 @@ func (c *GitHub) QueryForCommit(ctx context.Context, in QueryForCommitInput) (*Q
 	slog.Debug("Got the response", "response", q)
 	out := QueryForCommitOutput{
 		CurrentUserName:              q.Viewer.Login,
+		CurrentUser:                  newCurrentUser(q.Viewer.Login, q.Viewer.Name, q.Viewer.Email, q.Viewer.DatabaseID),
 		ParentDefaultBranchCommitSHA: git.CommitSHA(q.ParentRepository.DefaultBranchRef.Target.Commit.Oid),
 		ParentDefaultBranchTreeSHA:   git.TreeSHA(q.ParentRepository.DefaultBranchRef.Target.Commit.Tree.Oid),
 		ParentRefCommitSHA:           git.CommitSHA(q.ParentRepository.ParentRef.Target.Commit.Oid),
@@ -140,14 +148,45 @@ func (c *GitHub) CreateBranch(ctx context.Context, in CreateBranchInput) error {
 	return nil
 }
 
+// newCurrentUser returns the name and email of the current user.
+// If the email is not public, it falls back to the noreply email of GitHub,
+// which links the commit to the user as well as a commit on the web.
+func newCurrentUser(login, name, email string, databaseID int64) git.CommitAuthor {
+	if name == "" {
+		name = login
+	}
+	if email == "" {
+		email = fmt.Sprintf("%d+%s@users.noreply.github.com", databaseID, login)
+	}
+	return git.CommitAuthor{Name: name, Email: email}
+}
+
+// ErrNotFastForward is returned if the branch could not be updated
+// because the commit is not a descendant of the branch.
+// It typically occurs when another commit has been pushed to the branch.
+var ErrNotFastForward = errors.New("update is not a fast-forward")
+
+// ErrBranchMoved is returned if the branch could not be updated
+// because it does not point to the expected commit.
+// It typically occurs when another commit has been pushed to the branch.
+var ErrBranchMoved = errors.New("branch does not point to the expected commit")
+
 type UpdateBranchInput struct {
-	BranchRefNodeID InternalBranchNodeID
-	CommitSHA       git.CommitSHA
-	Force           bool
+	BranchRefNodeID   InternalBranchNodeID
+	CommitSHA         git.CommitSHA
+	Force             bool
+	ExpectedCommitSHA git.CommitSHA            // if set, update the branch only if it points to this commit (optional)
+	RepositoryNodeID  InternalRepositoryNodeID // required if ExpectedCommitSHA is set
+	BranchName        git.BranchName           // required if ExpectedCommitSHA is set
 }
 
 // UpdateBranch updates the branch and returns nil or an error.
+// It returns ErrNotFastForward if the update is rejected due to non-fast-forward.
+// If ExpectedCommitSHA is set, the branch is compared and updated atomically.
 func (c *GitHub) UpdateBranch(ctx context.Context, in UpdateBranchInput) error {
+	if in.ExpectedCommitSHA != "" {
+		return c.updateBranchIfUnchanged(ctx, in)
+	}
 	// https://docs.github.com/en/graphql/reference/mutations#updateref
 	v := githubv4.UpdateRefInput{
 		RefID: in.BranchRefNodeID,
@@ -163,8 +202,165 @@ func (c *GitHub) UpdateBranch(ctx context.Context, in UpdateBranchInput) error {
 		} `graphql:"updateRef(input: $input)"`
 	}
 	if err := c.Client.Mutate(ctx, &m, v, nil); err != nil {
+		if !in.Force && isNotFastForward(err) {
+			return fmt.Errorf("GitHub API error: %w: %w", ErrNotFastForward, err)
+		}
 		return fmt.Errorf("GitHub API error: %w", err)
 	}
 	slog.Debug("Got the response", "response", m)
 	return nil
 }
+
+// updateBranchIfUnchanged updates the branch only if it points to the expected commit.
+func (c *GitHub) updateBranchIfUnchanged(ctx context.Context, in UpdateBranchInput) error {
+	beforeOid := githubv4.GitObjectID(in.ExpectedCommitSHA)
+	err := c.updateRefs(ctx, in.RepositoryNodeID, githubv4.RefUpdate{
+		Name:      githubv4.GitRefname(in.BranchName.QualifiedName().String()),
+		AfterOid:  githubv4.GitObjectID(in.CommitSHA),
+		BeforeOid: &beforeOid,
+		Force:     githubv4.NewBoolean(githubv4.Boolean(in.Force)),
+	})
+	if err != nil {
+		if !in.Force && isNotFastForward(err) {
+			return fmt.Errorf("GitHub API error: %w: %w", ErrNotFastForward, err)
+		}
+		if isBranchMoved(err) {
+			return fmt.Errorf("GitHub API error: %w: %w", ErrBranchMoved, err)
+		}
+		return fmt.Errorf("GitHub API error: branch %s may not point to %s: %w", in.BranchName, in.ExpectedCommitSHA, err)
+	}
+	return nil
+}
+
+type DeleteBranchInput struct {
+	RepositoryNodeID  InternalRepositoryNodeID
+	BranchName        git.BranchName
+	ExpectedCommitSHA git.CommitSHA // delete the branch only if it points to this commit
+}
+
+// DeleteBranch deletes the branch only if it points to the expected commit.
+func (c *GitHub) DeleteBranch(ctx context.Context, in DeleteBranchInput) error {
+	beforeOid := githubv4.GitObjectID(in.ExpectedCommitSHA)
+	if err := c.updateRefs(ctx, in.RepositoryNodeID, githubv4.RefUpdate{
+		Name:      githubv4.GitRefname(in.BranchName.QualifiedName().String()),
+		AfterOid:  nullOid,
+		BeforeOid: &beforeOid,
+	}); err != nil {
+		return fmt.Errorf("GitHub API error: %w", err)
+	}
+	return nil
+}
+
+// nullOid represents no object, which deletes the ref in updateRefs.
+const nullOid = githubv4.GitObjectID("0000000000000000000000000000000000000000")
+
+// updateRefs updates the ref atomically.
+func (c *GitHub) updateRefs(ctx context.Context, repositoryNodeID InternalRepositoryNodeID, refUpdate githubv4.RefUpdate) error {
+	// https://docs.github.com/en/graphql/reference/mutations#updaterefs
+	v := githubv4.UpdateRefsInput{
+		RepositoryID: repositoryNodeID,
+		RefUpdates:   []githubv4.RefUpdate{refUpdate},
+	}
+	slog.Debug("Mutation updateRefs", "params", v)
+	var m struct {
+		UpdateRefs struct {
+			ClientMutationID string
+		} `graphql:"updateRefs(input: $input)"`
+	}
+	if err := c.Client.Mutate(ctx, &m, v, nil); err != nil {
+		return err
+	}
+	slog.Debug("Got the response", "response", m)
+	return nil
+}
+
+// isNotFastForward returns true if the error indicates the update is rejected due to non-fast-forward.
+// GitHub API returns a message like "Update is not a fast forward".
+func isNotFastForward(err error) bool {
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "not a fast forward") || strings.Contains(msg, "not a fast-forward")
+}
+
+// isBranchMoved returns true if the error indicates the ref does not point to beforeOid.
+// GitHub API returns a message of git like "cannot lock ref 'refs/heads/main': is at ... but expected ...".
+func isBranchMoved(err error) bool {
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "but expected") || strings.Contains(msg, "stale")
+}
+
+type CreateCommitOnBranchInput struct {
+	Repository      git.RepositoryID
+	BranchName      git.BranchName
+	ExpectedHeadOid git.CommitSHA // head of the branch before the commit
+	Message         git.CommitMessage
+	Additions       []FileAddition
+	Deletions       []string // filenames to delete, which must exist in the branch
+}
+
+// FileAddition represents a file to add or change.
+type FileAddition struct {
+	Filename string
+	Content  []byte
+}
+
+// CreateCommitOnBranch creates a commit on the branch and updates the branch.
+// GitHub signs the commit if the token belongs to a GitHub App.
+// It returns ErrNotFastForward if the branch does not point to ExpectedHeadOid.
+func (c *GitHub) CreateCommitOnBranch(ctx context.Context, in CreateCommitOnBranchInput) (git.CommitSHA, error) {
+	// https://docs.github.com/en/graphql/reference/mutations#createcommitonbranch
+	additions := make([]githubv4.FileAddition, len(in.Additions))
+	for i, addition := range in.Additions {
+		additions[i] = githubv4.FileAddition{
+			Path:     githubv4.String(addition.Filename),
+			Contents: githubv4.Base64String(base64.StdEncoding.EncodeToString(addition.Content)),
+		}
+	}
+	deletions := make([]githubv4.FileDeletion, len(in.Deletions))
+	for i, filename := range in.Deletions {
+		deletions[i] = githubv4.FileDeletion{Path: githubv4.String(filename)}
+	}
+	v := githubv4.CreateCommitOnBranchInput{
+		Branch: githubv4.CommittableBranch{
+			RepositoryNameWithOwner: githubv4.NewString(githubv4.String(in.Repository.String())),
+			BranchName:              githubv4.NewString(githubv4.String(in.BranchName)),
+		},
+		Message:         newCommitMessage(in.Message),
+		ExpectedHeadOid: githubv4.GitObjectID(in.ExpectedHeadOid),
+		FileChanges: &githubv4.FileChanges{
+			Additions: &additions,
+			Deletions: &deletions,
+		},
+	}
+	slog.Debug("Mutation createCommitOnBranch", "branch", v.Branch, "expectedHeadOid", in.ExpectedHeadOid, "additions", len(additions), "deletions", len(deletions))
+	var m struct {
+		CreateCommitOnBranch struct {
+			Commit struct {
+				Oid string
+			}
+		} `graphql:"createCommitOnBranch(input: $input)"`
+	}
+	if err := c.Client.Mutate(ctx, &m, v, nil); err != nil {
+		if isExpectedHeadMismatch(err) {
+			return "", fmt.Errorf("GitHub API error: %w: %w", ErrNotFastForward, err)
+		}
+		return "", fmt.Errorf("GitHub API error: %w", err)
+	}
+	slog.Debug("Got the response", "response", m)
+	return git.CommitSHA(m.CreateCommitOnBranch.Commit.Oid), nil
+}
+
+// newCommitMessage splits the message into the headline and body.
+func newCommitMessage(message git.CommitMessage) githubv4.CommitMessage {
+	headline, body, _ := strings.Cut(string(message), "\n")
+	m := githubv4.CommitMessage{Headline: githubv4.String(headline)}
+	if body = strings.TrimLeft(body, "\n"); body != "" {
+		m.Body = githubv4.NewString(githubv4.String(body))
+	}
+	return m
+}
+
+// isExpectedHeadMismatch returns true if the error indicates the branch does not point to expectedHeadOid.
+// GitHub API returns a message like "Expected branch to point to "..." but it did not".
+func isExpectedHeadMismatch(err error) bool {
+	return strings.Contains(strings.ToLower(err.Error()), "expected branch to point to")
+}
@@ -0,0 +1,193 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/int128/ghcp/mocks/github.com/int128/ghcp/pkg/github/client_mock"
+	"github.com/int128/ghcp/pkg/git"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGitHub_UpdateBranch(t *testing.T) {
+	ctx := context.TODO()
+	in := UpdateBranchInput{
+		BranchRefNodeID: "branchRefNodeID",
+		CommitSHA:       "commitSHA",
+	}
+	mutationInput := githubv4.UpdateRefInput{
+		RefID: "branchRefNodeID",
+		Oid:   "commitSHA",
+		Force: githubv4.NewBoolean(false),
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		gitHubClient := client_mock.NewMockInterface(t)
+		gitHubClient.EXPECT().
+			Mutate(ctx, mock.Anything, mutationInput, map[string]any(nil)).
+			Return(nil)
+		gitHub := GitHub{Client: gitHubClient}
+		if err := gitHub.UpdateBranch(ctx, in); err != nil {
+			t.Errorf("err wants nil but %+v", err)
+		}
+	})
+
+	t.Run("NotFastForward", func(t *testing.T) {
+		gitHubClient := client_mock.NewMockInterface(t)
+		gitHubClient.EXPECT().
+			Mutate(ctx, mock.Anything, mutationInput, map[string]any(nil)).
+			Return(errors.New("Update is not a fast forward"))
+		gitHub := GitHub{Client: gitHubClient}
+		err := gitHub.UpdateBranch(ctx, in)
+		if !errors.Is(err, ErrNotFastForward) {
+			t.Errorf("err wants ErrNotFastForward but %+v", err)
+		}
+	})
+
+	t.Run("OtherError", func(t *testing.T) {
+		gitHubClient := client_mock.NewMockInterface(t)
+		gitHubClient.EXPECT().
+			Mutate(ctx, mock.Anything, mutationInput, map[string]any(nil)).
+			Return(errors.New("Could not resolve to a node"))
+		gitHub := GitHub{Client: gitHubClient}
+		err := gitHub.UpdateBranch(ctx, in)
+		if err == nil {
+			t.Fatalf("err wants non-nil but nil")
+		}
+		if errors.Is(err, ErrNotFastForward) {
+			t.Errorf("err wants not ErrNotFastForward but %+v", err)
+		}
+	})
+
+	t.Run("ExpectedCommitSHA", func(t *testing.T) {
+		beforeOid := githubv4.GitObjectID("headCommitSHA")
+		gitHubClient := client_mock.NewMockInterface(t)
+		gitHubClient.EXPECT().
+			Mutate(ctx, mock.Anything, githubv4.UpdateRefsInput{
+				RepositoryID: "repositoryNodeID",
+				RefUpdates: []githubv4.RefUpdate{{
+					Name:      "refs/heads/topic",
+					AfterOid:  "commitSHA",
+					BeforeOid: &beforeOid,
+					Force:     githubv4.NewBoolean(true),
+				}},
+			}, map[string]any(nil)).
+			Return(nil)
+		gitHub := GitHub{Client: gitHubClient}
+		if err := gitHub.UpdateBranch(ctx, UpdateBranchInput{
+			BranchRefNodeID:   "branchRefNodeID",
+			CommitSHA:         "commitSHA",
+			Force:             true,
+			ExpectedCommitSHA: "headCommitSHA",
+			RepositoryNodeID:  "repositoryNodeID",
+			BranchName:        "topic",
+		}); err != nil {
+			t.Errorf("err wants nil but %+v", err)
+		}
+	})
+
+	t.Run("ExpectedCommitSHA/BranchMoved", func(t *testing.T) {
+		gitHubClient := client_mock.NewMockInterface(t)
+		gitHubClient.EXPECT().
+			Mutate(ctx, mock.Anything, mock.AnythingOfType("githubv4.UpdateRefsInput"), map[string]any(nil)).
+			Return(errors.New("cannot lock ref 'refs/heads/topic': is at newCommitSHA but expected headCommitSHA"))
+		gitHub := GitHub{Client: gitHubClient}
+		err := gitHub.UpdateBranch(ctx, UpdateBranchInput{
+			BranchRefNodeID:   "branchRefNodeID",
+			CommitSHA:         "commitSHA",
+			Force:             true,
+			ExpectedCommitSHA: "headCommitSHA",
+			RepositoryNodeID:  "repositoryNodeID",
+			BranchName:        "topic",
+		})
+		if !errors.Is(err, ErrBranchMoved) {
+			t.Errorf("err wants ErrBranchMoved but %+v", err)
+		}
+	})
+}
+
+func TestGitHub_DeleteBranch(t *testing.T) {
+	ctx := context.TODO()
+	beforeOid := githubv4.GitObjectID("commitSHA")
+	gitHubClient := client_mock.NewMockInterface(t)
+	gitHubClient.EXPECT().
+		Mutate(ctx, mock.Anything, githubv4.UpdateRefsInput{
+			RepositoryID: "repositoryNodeID",
+			RefUpdates: []githubv4.RefUpdate{{
+				Name:      "refs/heads/topic",
+				AfterOid:  "0000000000000000000000000000000000000000",
+				BeforeOid: &beforeOid,
+			}},
+		}, map[string]any(nil)).
+		Return(nil)
+	gitHub := GitHub{Client: gitHubClient}
+	if err := gitHub.DeleteBranch(ctx, DeleteBranchInput{
+		RepositoryNodeID:  "repositoryNodeID",
+		BranchName:        "topic",
+		ExpectedCommitSHA: "commitSHA",
+	}); err != nil {
+		t.Errorf("err wants nil but %+v", err)
+	}
+}
+
+func TestGitHub_CreateCommitOnBranch(t *testing.T) {
+	ctx := context.TODO()
+	in := CreateCommitOnBranchInput{
+		Repository:      git.RepositoryID{Owner: "owner", Name: "repo"},
+		BranchName:      "topic",
+		ExpectedHeadOid: "headCommitSHA",
+		Message:         "headline\n\nbody",
+		Additions:       []FileAddition{{Filename: "file1", Content: []byte("content1")}},
+		Deletions:       []string{"file2"},
+	}
+	mutationInput := githubv4.CreateCommitOnBranchInput{
+		Branch: githubv4.CommittableBranch{
+			RepositoryNameWithOwner: githubv4.NewString("owner/repo"),
+			BranchName:              githubv4.NewString("topic"),
+		},
+		Message: githubv4.CommitMessage{
+			Headline: "headline",
+			Body:     githubv4.NewString("body"),
+		},
+		ExpectedHeadOid: "headCommitSHA",
+		FileChanges: &githubv4.FileChanges{
+			Additions: &[]githubv4.FileAddition{{Path: "file1", Contents: "Y29udGVudDE="}},
+			Deletions: &[]githubv4.FileDeletion{{Path: "file2"}},
+		},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		gitHubClient := client_mock.NewMockInterface(t)
+		gitHubClient.EXPECT().
+			Mutate(ctx, mock.Anything, mutationInput, map[string]any(nil)).
+			Run(func(_ context.Context, m any, _ githubv4.Input, _ map[string]any) {
+				if err := json.Unmarshal([]byte(`{"CreateCommitOnBranch":{"Commit":{"Oid":"commitSHA"}}}`), m); err != nil {
+					t.Fatalf("json.Unmarshal: %s", err)
+				}
+			}).
+			Return(nil)
+		gitHub := GitHub{Client: gitHubClient}
+		commitSHA, err := gitHub.CreateCommitOnBranch(ctx, in)
+		if err != nil {
+			t.Fatalf("CreateCommitOnBranch returned error: %+v", err)
+		}
+		if commitSHA != "commitSHA" {
+			t.Errorf("commitSHA wants commitSHA but %s", commitSHA)
+		}
+	})
+
+	t.Run("ExpectedHeadMismatch", func(t *testing.T) {
+		gitHubClient := client_mock.NewMockInterface(t)
+		gitHubClient.EXPECT().
+			Mutate(ctx, mock.Anything, mutationInput, map[string]any(nil)).
+			Return(errors.New(`Expected branch to point to "headCommitSHA" but it did not. Pull and try again.`))
+		gitHub := GitHub{Client: gitHubClient}
+		_, err := gitHub.CreateCommitOnBranch(ctx, in)
+		if !errors.Is(err, ErrNotFastForward) {
+			t.Errorf("err wants ErrNotFastForward but %+v", err)
+		}
+	})
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/go-github/v88/github"
+)
+
+const (
+	blobRequestBodyPrefix = `{"encoding":"base64","content":"`
+	blobRequestBodySuffix = `"}`
+)
+
+type blobService struct {
+	client *github.Client
+}
+
+// CreateBlobFromReader creates a blob with the content.
+// Unlike CreateBlob of go-github, the content is base64 encoded and streamed into the request body,
+// so that it does not hold the whole content in memory.
+//
+// The size must be the length of the raw content.
+//
+// https://docs.github.com/en/rest/git/blobs#create-a-blob
+func (s *blobService) CreateBlobFromReader(ctx context.Context, owner, repo string, content io.Reader, size int64) (*github.Blob, *github.Response, error) {
+	// NewUploadRequest resolves the URL relative to the upload URL, so pass the absolute URL of the API
+	u := fmt.Sprintf("%srepos/%v/%v/git/blobs", s.client.BaseURL(), owner, repo)
+	body := newBase64Reader(content)
+	defer func() {
+		// stop encoding if the request was aborted
+		_ = body.Close()
+	}()
+	r := io.MultiReader(
+		strings.NewReader(blobRequestBodyPrefix),
+		body,
+		strings.NewReader(blobRequestBodySuffix),
+	)
+	bodySize := int64(len(blobRequestBodyPrefix)) + base64EncodedLen(size) + int64(len(blobRequestBodySuffix))
+	req, err := s.client.NewUploadRequest(ctx, u, r, bodySize, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+	var blob github.Blob
+	resp, err := s.client.Do(req, &blob)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &blob, resp, nil
+}
+
+// newBase64Reader returns a reader of the base64 encoded content.
+// The caller must close the reader.
+func newBase64Reader(content io.Reader) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		e := base64.NewEncoder(base64.StdEncoding, pw)
+		_, err := io.Copy(e, content)
+		if err == nil {
+			err = e.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+	return pr
+}
+
+func base64EncodedLen(n int64) int64 {
+	return (n + 2) / 3 * 4
+}
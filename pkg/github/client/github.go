@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"os"
@@ -22,6 +23,8 @@ type NewFunc func(Option) (Interface, error)
 type Interface interface {
 	QueryService
 	GitService
+	BlobService
+	LFSService
 	RepositoriesService
 }
 
@@ -31,9 +34,20 @@ type QueryService interface {
 }
 
 type GitService interface {
+	GetCommit(ctx context.Context, owner string, repo string, sha string) (*github.Commit, *github.Response, error)
 	CreateCommit(ctx context.Context, owner string, repo string, commit github.Commit, opts *github.CreateCommitOptions) (*github.Commit, *github.Response, error)
+	GetTree(ctx context.Context, owner string, repo string, sha string, recursive bool) (*github.Tree, *github.Response, error)
 	CreateTree(ctx context.Context, owner string, repo string, baseTree string, entries []*github.TreeEntry) (*github.Tree, *github.Response, error)
-	CreateBlob(ctx context.Context, owner string, repo string, blob github.Blob) (*github.Blob, *github.Response, error)
+}
+
+type BlobService interface {
+	CreateBlobFromReader(ctx context.Context, owner, repo string, content io.Reader, size int64) (*github.Blob, *github.Response, error)
+}
+
+type LFSService interface {
+	BatchLFS(ctx context.Context, owner, repo string, batch LFSBatchRequest) (*LFSBatchResponse, error)
+	UploadLFSObject(ctx context.Context, action LFSAction, content io.Reader, size int64) error
+	VerifyLFSObject(ctx context.Context, action LFSAction, object LFSObject) error
 }
 
 type RepositoriesService interface {
@@ -55,6 +69,8 @@ type Option struct {
 type clientSet struct {
 	QueryService
 	GitService
+	BlobService
+	LFSService
 	RepositoriesService
 }
 
@@ -63,9 +79,15 @@ func New(o Option) (Interface, error) {
 	if err != nil {
 		return nil, fmt.Errorf("error while initializing GitHub client: %w", err)
 	}
+	lfsServerURL, err := buildLFSServerURL(o.URLv3)
+	if err != nil {
+		return nil, fmt.Errorf("error while initializing Git LFS client: %w", err)
+	}
 	return &clientSet{
 		QueryService:        v4,
 		GitService:          v3.Git,
+		BlobService:         &blobService{client: v3},
+		LFSService:          &lfsService{client: &http.Client{}, serverURL: lfsServerURL, token: o.Token},
 		RepositoriesService: v3.Repositories,
 	}, nil
 }
@@ -2,9 +2,11 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/google/go-github/v88/github"
@@ -24,8 +26,18 @@ func TestNewGitHubClient(t *testing.T) {
 				t.Errorf("error while writing body: %s", err)
 			}
 		case r.Method == "POST" && r.URL.Path == "/api/v3/repos/owner/repo/git/blobs":
+			var blob github.Blob
+			if err := json.NewDecoder(r.Body).Decode(&blob); err != nil {
+				t.Errorf("error while decoding body: %s", err)
+			}
+			if want := "aGVsbG8gd29ybGQ="; blob.GetContent() != want {
+				t.Errorf("content wants %s but %s", want, blob.GetContent())
+			}
+			if want := "base64"; blob.GetEncoding() != want {
+				t.Errorf("encoding wants %s but %s", want, blob.GetEncoding())
+			}
 			w.Header().Set("content-type", "application/json")
-			if _, err := fmt.Fprint(w, "{}"); err != nil {
+			if _, err := fmt.Fprint(w, `{"sha":"BLOB_SHA"}`); err != nil {
 				t.Errorf("error while writing body: %s", err)
 			}
 		default:
@@ -52,7 +64,11 @@ func TestNewGitHubClient(t *testing.T) {
 	}
 
 	// v3 API
-	if _, _, err := c.CreateBlob(ctx, "owner", "repo", github.Blob{}); err != nil {
-		t.Errorf("CreateBlob returned error: %s", err)
+	blob, _, err := c.CreateBlobFromReader(ctx, "owner", "repo", strings.NewReader("hello world"), 11)
+	if err != nil {
+		t.Fatalf("CreateBlobFromReader returned error: %s", err)
+	}
+	if want := "BLOB_SHA"; blob.GetSHA() != want {
+		t.Errorf("SHA wants %s but %s", want, blob.GetSHA())
 	}
 }
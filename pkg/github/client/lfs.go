@@ -0,0 +1,166 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+const lfsMediaType = "application/vnd.git-lfs+json"
+
+// LFSBatchRequest represents a request of the Git LFS Batch API.
+// https://github.com/git-lfs/git-lfs/blob/main/docs/api/batch.md
+type LFSBatchRequest struct {
+	Operation string      `json:"operation"`
+	Transfers []string    `json:"transfers,omitempty"`
+	Objects   []LFSObject `json:"objects"`
+	HashAlgo  string      `json:"hash_algo,omitempty"`
+}
+
+// LFSBatchResponse represents a response of the Git LFS Batch API.
+type LFSBatchResponse struct {
+	Transfer string              `json:"transfer,omitempty"`
+	Objects  []LFSObjectResponse `json:"objects"`
+}
+
+type LFSObject struct {
+	OID  string `json:"oid"`
+	Size int64  `json:"size"`
+}
+
+type LFSObjectResponse struct {
+	LFSObject
+	Actions map[string]LFSAction `json:"actions,omitempty"` // nil if the server already has the object
+	Error   *LFSObjectError      `json:"error,omitempty"`
+}
+
+type LFSAction struct {
+	Href   string            `json:"href"`
+	Header map[string]string `json:"header,omitempty"`
+}
+
+type LFSObjectError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *LFSObjectError) Error() string {
+	return fmt.Sprintf("%s (code %d)", e.Message, e.Code)
+}
+
+type lfsService struct {
+	client    *http.Client
+	serverURL *url.URL // e.g. https://github.com/
+	token     string
+}
+
+// BatchLFS requests the Git LFS Batch API of the repository.
+// The endpoint is determined by the server URL and the repository,
+// e.g. https://github.com/OWNER/REPO.git/info/lfs/objects/batch
+func (s *lfsService) BatchLFS(ctx context.Context, owner, repo string, batch LFSBatchRequest) (*LFSBatchResponse, error) {
+	u := s.serverURL.JoinPath(owner, repo+".git", "info", "lfs", "objects", "batch")
+	b, err := json.Marshal(batch)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode the request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewReader(b))
+	if err != nil {
+		return nil, fmt.Errorf("could not create a request: %w", err)
+	}
+	req.Header.Set("Accept", lfsMediaType)
+	req.Header.Set("Content-Type", lfsMediaType)
+	req.SetBasicAuth("x-access-token", s.token)
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if err := checkLFSResponse(resp); err != nil {
+		return nil, err
+	}
+	var batchResponse LFSBatchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&batchResponse); err != nil {
+		return nil, fmt.Errorf("could not decode the response: %w", err)
+	}
+	return &batchResponse, nil
+}
+
+// UploadLFSObject uploads the content by the upload action.
+// The credential is not sent, because the action has the header for authorization.
+func (s *lfsService) UploadLFSObject(ctx context.Context, action LFSAction, content io.Reader, size int64) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, action.Href, content)
+	if err != nil {
+		return fmt.Errorf("could not create a request: %w", err)
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", "application/octet-stream")
+	for k, v := range action.Header {
+		req.Header.Set(k, v)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return checkLFSResponse(resp)
+}
+
+// VerifyLFSObject requests the verify action.
+func (s *lfsService) VerifyLFSObject(ctx context.Context, action LFSAction, object LFSObject) error {
+	b, err := json.Marshal(object)
+	if err != nil {
+		return fmt.Errorf("could not encode the request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, action.Href, bytes.NewReader(b))
+	if err != nil {
+		return fmt.Errorf("could not create a request: %w", err)
+	}
+	req.Header.Set("Accept", lfsMediaType)
+	req.Header.Set("Content-Type", lfsMediaType)
+	for k, v := range action.Header {
+		req.Header.Set(k, v)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return checkLFSResponse(resp)
+}
+
+func checkLFSResponse(resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	var body struct {
+		Message string `json:"message"`
+	}
+	// omit the query string, because it may contain a signature
+	u := resp.Request.URL.Host + resp.Request.URL.Path
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil || body.Message == "" {
+		return fmt.Errorf("%s %s: %s", resp.Request.Method, u, resp.Status)
+	}
+	return fmt.Errorf("%s %s: %s: %s", resp.Request.Method, u, resp.Status, body.Message)
+}
+
+// buildLFSServerURL returns the URL of GitHub server.
+// If v3 is empty, it returns the URL of github.com.
+func buildLFSServerURL(v3 string) (*url.URL, error) {
+	if v3 == "" {
+		return &url.URL{Scheme: "https", Host: "github.com", Path: "/"}, nil
+	}
+	v3URL, err := url.Parse(v3)
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing v3 URL: %w", err)
+	}
+	// e.g. https://github.example.com/api/v3/ -> https://github.example.com/
+	serverURL, err := v3URL.Parse("../../")
+	if err != nil {
+		return nil, fmt.Errorf("error while building LFS URL: %w", err)
+	}
+	return serverURL, nil
+}
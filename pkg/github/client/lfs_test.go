@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLFSService(t *testing.T) {
+	ctx := context.TODO()
+	var uploaded, verified string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/owner/repo.git/info/lfs/objects/batch":
+			if username, password, _ := r.BasicAuth(); password != "YOUR_TOKEN" {
+				t.Errorf("password wants YOUR_TOKEN but %s (username %s)", password, username)
+			}
+			if want := lfsMediaType; r.Header.Get("content-type") != want {
+				t.Errorf("content-type wants %s but %s", want, r.Header.Get("content-type"))
+			}
+			var batch LFSBatchRequest
+			if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+				t.Errorf("error while decoding body: %s", err)
+			}
+			want := LFSBatchRequest{
+				Operation: "upload",
+				Transfers: []string{"basic"},
+				Objects:   []LFSObject{{OID: "OID", Size: 11}},
+				HashAlgo:  "sha256",
+			}
+			if diff := cmp.Diff(want, batch); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			w.Header().Set("content-type", lfsMediaType)
+			if _, err := fmt.Fprintf(w, `{"transfer":"basic","objects":[{"oid":"OID","size":11,"actions":{
+				"upload":{"href":"http://%s/storage/OID","header":{"Authorization":"RemoteAuth UPLOAD"}},
+				"verify":{"href":"http://%s/verify","header":{"Authorization":"RemoteAuth VERIFY"}}}}]}`, r.Host, r.Host); err != nil {
+				t.Errorf("error while writing body: %s", err)
+			}
+		case r.Method == "PUT" && r.URL.Path == "/storage/OID":
+			if want := "RemoteAuth UPLOAD"; r.Header.Get("authorization") != want {
+				t.Errorf("authorization wants %s but %s", want, r.Header.Get("authorization"))
+			}
+			b, err := io.ReadAll(r.Body)
+			if err != nil {
+				t.Errorf("error while reading body: %s", err)
+			}
+			uploaded = string(b)
+		case r.Method == "POST" && r.URL.Path == "/verify":
+			if want := "RemoteAuth VERIFY"; r.Header.Get("authorization") != want {
+				t.Errorf("authorization wants %s but %s", want, r.Header.Get("authorization"))
+			}
+			b, err := io.ReadAll(r.Body)
+			if err != nil {
+				t.Errorf("error while reading body: %s", err)
+			}
+			verified = string(b)
+		default:
+			t.Logf("Not found: %s %s", r.Method, r.URL)
+			http.NotFound(w, r)
+		}
+	}))
+	defer s.Close()
+
+	c, err := New(Option{Token: "YOUR_TOKEN", URLv3: s.URL + "/api/v3/"})
+	if err != nil {
+		t.Fatalf("New returned error: %s", err)
+	}
+	batch, err := c.BatchLFS(ctx, "owner", "repo", LFSBatchRequest{
+		Operation: "upload",
+		Transfers: []string{"basic"},
+		Objects:   []LFSObject{{OID: "OID", Size: 11}},
+		HashAlgo:  "sha256",
+	})
+	if err != nil {
+		t.Fatalf("BatchLFS returned error: %s", err)
+	}
+	if len(batch.Objects) != 1 {
+		t.Fatalf("len(Objects) wants 1 but %d", len(batch.Objects))
+	}
+	actions := batch.Objects[0].Actions
+	if err := c.UploadLFSObject(ctx, actions["upload"], strings.NewReader("hello world"), 11); err != nil {
+		t.Fatalf("UploadLFSObject returned error: %s", err)
+	}
+	if want := "hello world"; uploaded != want {
+		t.Errorf("uploaded wants %s but %s", want, uploaded)
+	}
+	if err := c.VerifyLFSObject(ctx, actions["verify"], LFSObject{OID: "OID", Size: 11}); err != nil {
+		t.Fatalf("VerifyLFSObject returned error: %s", err)
+	}
+	if want := `{"oid":"OID","size":11}`; verified != want {
+		t.Errorf("verified wants %s but %s", want, verified)
+	}
+
+	t.Run("Error", func(t *testing.T) {
+		_, err := c.BatchLFS(ctx, "owner", "missing", LFSBatchRequest{Operation: "upload"})
+		if err == nil {
+			t.Fatalf("err wants non-nil but nil")
+		}
+	})
+}
+
+func Test_buildLFSServerURL(t *testing.T) {
+	for _, c := range []struct {
+		v3   string
+		want string
+	}{
+		{"", "https://github.com/"},
+		{"https://github.example.com/api/v3/", "https://github.example.com/"},
+		{"https://github.example.com/api/v3", "https://github.example.com/"},
+	} {
+		t.Run(c.v3, func(t *testing.T) {
+			got, err := buildLFSServerURL(c.v3)
+			if err != nil {
+				t.Fatalf("buildLFSServerURL returned error: %s", err)
+			}
+			if got.String() != c.want {
+				t.Errorf("buildLFSServerURL wants %s but %s", c.want, got)
+			}
+		})
+	}
+}
@@ -20,11 +20,16 @@ type Interface interface {
 	QueryForCommit(ctx context.Context, in QueryForCommitInput) (*QueryForCommitOutput, error)
 	CreateBranch(ctx context.Context, in CreateBranchInput) error
 	UpdateBranch(ctx context.Context, in UpdateBranchInput) error
+	DeleteBranch(ctx context.Context, in DeleteBranchInput) error
+	CreateCommitOnBranch(ctx context.Context, in CreateCommitOnBranchInput) (git.CommitSHA, error)
 	CreateCommit(ctx context.Context, commit git.NewCommit) (git.CommitSHA, error)
 
 	QueryCommit(ctx context.Context, in QueryCommitInput) (*QueryCommitOutput, error)
+	GetCommit(ctx context.Context, repo git.RepositoryID, sha git.CommitSHA) (*git.Commit, error)
+	GetTree(ctx context.Context, repo git.RepositoryID, sha git.TreeSHA) (*git.Tree, error)
 	CreateTree(ctx context.Context, tree git.NewTree) (git.TreeSHA, error)
 	CreateBlob(ctx context.Context, blob git.NewBlob) (git.BlobSHA, error)
+	UploadLFSObject(ctx context.Context, in UploadLFSObjectInput) error
 
 	GetReleaseByTagOrNil(ctx context.Context, repo git.RepositoryID, tag git.TagName) (*git.Release, error)
 	CreateRelease(ctx context.Context, r git.Release) (*git.Release, error)
@@ -2,6 +2,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 
@@ -18,6 +19,7 @@ type QueryCommitInput struct {
 
 type QueryCommitOutput struct {
 	ChangedFiles int
+	TreeSHA      git.TreeSHA
 }
 
 // QueryCommit returns the commit.
@@ -27,6 +29,9 @@ func (c *GitHub) QueryCommit(ctx context.Context, in QueryCommitInput) (*QueryCo
 			Object struct {
 				Commit struct {
 					ChangedFiles int
+					Tree         struct {
+						Oid string
+					}
 				} `graphql:"... on Commit"`
 			} `graphql:"object(oid: $commitSHA)"`
 		} `graphql:"repository(owner: $owner, name: $repo)"`
@@ -43,6 +48,7 @@ func (c *GitHub) QueryCommit(ctx context.Context, in QueryCommitInput) (*QueryCo
 	slog.Debug("Got the response", "response", q)
 	out := QueryCommitOutput{
 		ChangedFiles: q.Repository.Object.Commit.ChangedFiles,
+		TreeSHA:      git.TreeSHA(q.Repository.Object.Commit.Tree.Oid),
 	}
 	slog.Debug("Returning the commit", "commit", out)
 	return &out, nil
@@ -52,8 +58,8 @@ func (c *GitHub) QueryCommit(ctx context.Context, in QueryCommitInput) (*QueryCo
 func (c *GitHub) CreateCommit(ctx context.Context, n git.NewCommit) (git.CommitSHA, error) {
 	slog.Debug("Creating a commit", "input", n)
 	var parents []*github.Commit
-	if n.ParentCommitSHA != "" {
-		parents = append(parents, &github.Commit{SHA: github.Ptr(string(n.ParentCommitSHA))})
+	for _, parent := range n.Parents() {
+		parents = append(parents, &github.Commit{SHA: github.Ptr(string(parent))})
 	}
 	commit := github.Commit{
 		Message: github.Ptr(string(n.Message)),
@@ -61,16 +67,14 @@ func (c *GitHub) CreateCommit(ctx context.Context, n git.NewCommit) (git.CommitS
 		Tree:    &github.Tree{SHA: github.Ptr(string(n.TreeSHA))},
 	}
 	if n.Author != nil {
-		commit.Author = &github.CommitAuthor{
-			Name:  github.Ptr(n.Author.Name),
-			Email: github.Ptr(n.Author.Email),
-		}
+		commit.Author = newCommitAuthor(*n.Author)
 	}
 	if n.Committer != nil {
-		commit.Committer = &github.CommitAuthor{
-			Name:  github.Ptr(n.Committer.Name),
-			Email: github.Ptr(n.Committer.Email),
-		}
+		commit.Committer = newCommitAuthor(*n.Committer)
+	}
+	if n.Signature != "" {
+		// GitHub adds the signature to the gpgsig header of the commit
+		commit.Verification = &github.SignatureVerification{Signature: github.Ptr(n.Signature)}
 	}
 	created, _, err := c.Client.CreateCommit(ctx, n.Repository.Owner, n.Repository.Name, commit, nil)
 	if err != nil {
@@ -79,11 +83,92 @@ func (c *GitHub) CreateCommit(ctx context.Context, n git.NewCommit) (git.CommitS
 	return git.CommitSHA(created.GetSHA()), nil
 }
 
+// GetCommit returns the commit including the parents.
+func (c *GitHub) GetCommit(ctx context.Context, repo git.RepositoryID, sha git.CommitSHA) (*git.Commit, error) {
+	slog.Debug("Getting the commit", "commit", sha, "repository", repo)
+	commit, _, err := c.Client.GetCommit(ctx, repo.Owner, repo.Name, string(sha))
+	if err != nil {
+		return nil, fmt.Errorf("GitHub API error: %w", err)
+	}
+	var parents []git.CommitSHA
+	for _, parent := range commit.Parents {
+		parents = append(parents, git.CommitSHA(parent.GetSHA()))
+	}
+	return &git.Commit{
+		SHA:       git.CommitSHA(commit.GetSHA()),
+		Message:   git.CommitMessage(commit.GetMessage()),
+		Author:    toCommitAuthor(commit.GetAuthor()),
+		Committer: toCommitAuthor(commit.GetCommitter()),
+		Parents:   parents,
+		TreeSHA:   git.TreeSHA(commit.GetTree().GetSHA()),
+	}, nil
+}
+
+func toCommitAuthor(a *github.CommitAuthor) git.CommitAuthor {
+	return git.CommitAuthor{
+		Name:  a.GetName(),
+		Email: a.GetEmail(),
+		Date:  a.GetDate().Time,
+	}
+}
+
+func newCommitAuthor(a git.CommitAuthor) *github.CommitAuthor {
+	author := &github.CommitAuthor{
+		Name:  github.Ptr(a.Name),
+		Email: github.Ptr(a.Email),
+	}
+	if !a.Date.IsZero() {
+		author.Date = &github.Timestamp{Time: a.Date}
+	}
+	return author
+}
+
+// ErrTreeTruncated is returned if the tree has too many entries to get at once.
+var ErrTreeTruncated = errors.New("tree is truncated because it has too many entries")
+
+// GetTree returns the regular files in the tree recursively.
+// It returns an error if the tree is too large to get at once.
+func (c *GitHub) GetTree(ctx context.Context, repo git.RepositoryID, sha git.TreeSHA) (*git.Tree, error) {
+	slog.Debug("Getting the tree", "tree", sha, "repository", repo)
+	tree, _, err := c.Client.GetTree(ctx, repo.Owner, repo.Name, string(sha), true)
+	if err != nil {
+		return nil, fmt.Errorf("GitHub API error: %w", err)
+	}
+	if tree.GetTruncated() {
+		return nil, fmt.Errorf("could not get the tree %s: %w", sha, ErrTreeTruncated)
+	}
+	var files []git.File
+	for _, entry := range tree.Entries {
+		if entry.GetType() != "blob" {
+			continue
+		}
+		switch entry.GetMode() {
+		case "100644", "100755":
+			files = append(files, git.File{
+				Filename:   entry.GetPath(),
+				BlobSHA:    git.BlobSHA(entry.GetSHA()),
+				Executable: entry.GetMode() == "100755",
+			})
+		}
+	}
+	slog.Debug("Got the tree", "tree", sha, "files", len(files))
+	return &git.Tree{SHA: git.TreeSHA(tree.GetSHA()), Files: files}, nil
+}
+
 // CreateTree creates a tree and returns SHA of it.
 func (c *GitHub) CreateTree(ctx context.Context, n git.NewTree) (git.TreeSHA, error) {
 	slog.Debug("Creating a tree", "input", n)
 	entries := make([]*github.TreeEntry, len(n.Files))
 	for i, file := range n.Files {
+		if file.Deleted {
+			// go-github sends {"sha":null} if both SHA and Content are nil
+			entries[i] = &github.TreeEntry{
+				Type: github.Ptr("blob"),
+				Path: github.Ptr(file.Filename),
+				Mode: github.Ptr(file.Mode()),
+			}
+			continue
+		}
 		entries[i] = &github.TreeEntry{
 			Type: github.Ptr("blob"),
 			Path: github.Ptr(file.Filename),
@@ -98,13 +183,15 @@ func (c *GitHub) CreateTree(ctx context.Context, n git.NewTree) (git.TreeSHA, er
 	return git.TreeSHA(tree.GetSHA()), nil
 }
 
+// MaxBlobSize is the maximum size of a blob which can be created via the API.
+// https://docs.github.com/en/repositories/working-with-files/managing-large-files/about-large-files-on-github
+const MaxBlobSize = 100 * 1024 * 1024
+
 // CreateBlob creates a blob and returns SHA of it.
+// The content is streamed into the request.
 func (c *GitHub) CreateBlob(ctx context.Context, n git.NewBlob) (git.BlobSHA, error) {
-	slog.Debug("Creating a blob", "size", len(n.Content), "repository", n.Repository)
-	blob, _, err := c.Client.CreateBlob(ctx, n.Repository.Owner, n.Repository.Name, github.Blob{
-		Encoding: github.Ptr("base64"),
-		Content:  github.Ptr(n.Content),
-	})
+	slog.Debug("Creating a blob", "size", n.Size, "repository", n.Repository)
+	blob, _, err := c.Client.CreateBlobFromReader(ctx, n.Repository.Owner, n.Repository.Name, n.Content, n.Size)
 	if err != nil {
 		return "", fmt.Errorf("GitHub API error: %w", err)
 	}
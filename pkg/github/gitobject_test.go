@@ -3,7 +3,9 @@ package github
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-github/v88/github"
 	"github.com/int128/ghcp/mocks/github.com/int128/ghcp/pkg/github/client_mock"
 	"github.com/int128/ghcp/pkg/git"
@@ -41,6 +43,35 @@ func TestGitHub_CreateCommit(t *testing.T) {
 		}
 	})
 
+	t.Run("MergeCommit", func(t *testing.T) {
+		gitHubClient := client_mock.NewMockInterface(t)
+		gitHubClient.EXPECT().
+			CreateCommit(ctx, "owner", "repo", github.Commit{
+				Message: github.Ptr("message"),
+				Parents: []*github.Commit{{SHA: github.Ptr("parentCommitSHA")}, {SHA: github.Ptr("mergeCommitSHA")}},
+				Tree:    &github.Tree{SHA: github.Ptr("treeSHA")},
+			}, (*github.CreateCommitOptions)(nil)).
+			Return(&github.Commit{
+				SHA: github.Ptr("commitSHA"),
+			}, nil, nil)
+		gitHub := GitHub{
+			Client: gitHubClient,
+		}
+		commitSHA, err := gitHub.CreateCommit(ctx, git.NewCommit{
+			Repository:      repositoryID,
+			Message:         "message",
+			ParentCommitSHA: "parentCommitSHA",
+			MergeParentSHAs: []git.CommitSHA{"mergeCommitSHA"},
+			TreeSHA:         "treeSHA",
+		})
+		if err != nil {
+			t.Fatalf("CreateCommit returned error: %+v", err)
+		}
+		if commitSHA != "commitSHA" {
+			t.Errorf("commitSHA wants commitSHA but %s", commitSHA)
+		}
+	})
+
 	t.Run("NoParent", func(t *testing.T) {
 		gitHubClient := client_mock.NewMockInterface(t)
 		gitHubClient.EXPECT().
@@ -66,4 +97,178 @@ func TestGitHub_CreateCommit(t *testing.T) {
 			t.Errorf("commitSHA wants commitSHA but %s", commitSHA)
 		}
 	})
+
+	t.Run("Signature", func(t *testing.T) {
+		date := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		gitHubClient := client_mock.NewMockInterface(t)
+		gitHubClient.EXPECT().
+			CreateCommit(ctx, "owner", "repo", github.Commit{
+				Message: github.Ptr("message"),
+				Tree:    &github.Tree{SHA: github.Ptr("treeSHA")},
+				Author: &github.CommitAuthor{
+					Name:  github.Ptr("Alice"),
+					Email: github.Ptr("alice@example.com"),
+					Date:  &github.Timestamp{Time: date},
+				},
+				Committer: &github.CommitAuthor{
+					Name:  github.Ptr("Alice"),
+					Email: github.Ptr("alice@example.com"),
+					Date:  &github.Timestamp{Time: date},
+				},
+				Verification: &github.SignatureVerification{Signature: github.Ptr("SIGNATURE")},
+			}, (*github.CreateCommitOptions)(nil)).
+			Return(&github.Commit{
+				SHA: github.Ptr("commitSHA"),
+			}, nil, nil)
+		gitHub := GitHub{
+			Client: gitHubClient,
+		}
+		commitSHA, err := gitHub.CreateCommit(ctx, git.NewCommit{
+			Repository: repositoryID,
+			Message:    "message",
+			Author:     &git.CommitAuthor{Name: "Alice", Email: "alice@example.com", Date: date},
+			Committer:  &git.CommitAuthor{Name: "Alice", Email: "alice@example.com", Date: date},
+			TreeSHA:    "treeSHA",
+			Signature:  "SIGNATURE",
+		})
+		if err != nil {
+			t.Fatalf("CreateCommit returned error: %+v", err)
+		}
+		if commitSHA != "commitSHA" {
+			t.Errorf("commitSHA wants commitSHA but %s", commitSHA)
+		}
+	})
+}
+
+func TestGitHub_GetCommit(t *testing.T) {
+	ctx := context.TODO()
+	repositoryID := git.RepositoryID{Owner: "owner", Name: "repo"}
+	date := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	gitHubClient := client_mock.NewMockInterface(t)
+	gitHubClient.EXPECT().
+		GetCommit(ctx, "owner", "repo", "commitSHA").
+		Return(&github.Commit{
+			SHA:       github.Ptr("commitSHA"),
+			Message:   github.Ptr("message"),
+			Author:    &github.CommitAuthor{Name: github.Ptr("Alice"), Email: github.Ptr("alice@example.com"), Date: &github.Timestamp{Time: date}},
+			Committer: &github.CommitAuthor{Name: github.Ptr("Bob"), Email: github.Ptr("bob@example.com"), Date: &github.Timestamp{Time: date}},
+			Parents:   []*github.Commit{{SHA: github.Ptr("parentCommitSHA")}},
+			Tree:      &github.Tree{SHA: github.Ptr("treeSHA")},
+		}, nil, nil)
+	gitHub := GitHub{
+		Client: gitHubClient,
+	}
+	got, err := gitHub.GetCommit(ctx, repositoryID, "commitSHA")
+	if err != nil {
+		t.Fatalf("GetCommit returned error: %+v", err)
+	}
+	want := &git.Commit{
+		SHA:       "commitSHA",
+		Message:   "message",
+		Author:    git.CommitAuthor{Name: "Alice", Email: "alice@example.com", Date: date},
+		Committer: git.CommitAuthor{Name: "Bob", Email: "bob@example.com", Date: date},
+		Parents:   []git.CommitSHA{"parentCommitSHA"},
+		TreeSHA:   "treeSHA",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGitHub_CreateTree(t *testing.T) {
+	ctx := context.TODO()
+	repositoryID := git.RepositoryID{Owner: "owner", Name: "repo"}
+
+	t.Run("DeletedFile", func(t *testing.T) {
+		gitHubClient := client_mock.NewMockInterface(t)
+		gitHubClient.EXPECT().
+			CreateTree(ctx, "owner", "repo", "baseTreeSHA", []*github.TreeEntry{
+				{
+					Type: github.Ptr("blob"),
+					Path: github.Ptr("file1"),
+					Mode: github.Ptr("100644"),
+					SHA:  github.Ptr("blobSHA1"),
+				},
+				{
+					Type: github.Ptr("blob"),
+					Path: github.Ptr("file2"),
+					Mode: github.Ptr("100644"),
+				},
+			}).
+			Return(&github.Tree{
+				SHA: github.Ptr("treeSHA"),
+			}, nil, nil)
+		gitHub := GitHub{
+			Client: gitHubClient,
+		}
+		treeSHA, err := gitHub.CreateTree(ctx, git.NewTree{
+			Repository:  repositoryID,
+			BaseTreeSHA: "baseTreeSHA",
+			Files: []git.File{
+				{Filename: "file1", BlobSHA: "blobSHA1"},
+				{Filename: "file2", Deleted: true},
+			},
+		})
+		if err != nil {
+			t.Fatalf("CreateTree returned error: %+v", err)
+		}
+		if treeSHA != "treeSHA" {
+			t.Errorf("treeSHA wants treeSHA but %s", treeSHA)
+		}
+	})
+}
+
+func TestGitHub_GetTree(t *testing.T) {
+	ctx := context.TODO()
+	repositoryID := git.RepositoryID{Owner: "owner", Name: "repo"}
+
+	t.Run("RegularFiles", func(t *testing.T) {
+		gitHubClient := client_mock.NewMockInterface(t)
+		gitHubClient.EXPECT().
+			GetTree(ctx, "owner", "repo", "treeSHA", true).
+			Return(&github.Tree{
+				SHA: github.Ptr("treeSHA"),
+				Entries: []*github.TreeEntry{
+					{Type: github.Ptr("blob"), Path: github.Ptr("file1"), Mode: github.Ptr("100644"), SHA: github.Ptr("blobSHA1")},
+					{Type: github.Ptr("tree"), Path: github.Ptr("dir"), Mode: github.Ptr("040000"), SHA: github.Ptr("dirTreeSHA")},
+					{Type: github.Ptr("blob"), Path: github.Ptr("dir/file2"), Mode: github.Ptr("100755"), SHA: github.Ptr("blobSHA2")},
+					{Type: github.Ptr("blob"), Path: github.Ptr("dir/link"), Mode: github.Ptr("120000"), SHA: github.Ptr("blobSHA3")},
+					{Type: github.Ptr("commit"), Path: github.Ptr("submodule"), Mode: github.Ptr("160000"), SHA: github.Ptr("commitSHA")},
+				},
+			}, nil, nil)
+		gitHub := GitHub{
+			Client: gitHubClient,
+		}
+		got, err := gitHub.GetTree(ctx, repositoryID, "treeSHA")
+		if err != nil {
+			t.Fatalf("GetTree returned error: %+v", err)
+		}
+		want := &git.Tree{
+			SHA: "treeSHA",
+			Files: []git.File{
+				{Filename: "file1", BlobSHA: "blobSHA1"},
+				{Filename: "dir/file2", BlobSHA: "blobSHA2", Executable: true},
+			},
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("Truncated", func(t *testing.T) {
+		gitHubClient := client_mock.NewMockInterface(t)
+		gitHubClient.EXPECT().
+			GetTree(ctx, "owner", "repo", "treeSHA", true).
+			Return(&github.Tree{
+				SHA:       github.Ptr("treeSHA"),
+				Truncated: github.Ptr(true),
+			}, nil, nil)
+		gitHub := GitHub{
+			Client: gitHubClient,
+		}
+		if _, err := gitHub.GetTree(ctx, repositoryID, "treeSHA"); err == nil {
+			t.Errorf("err wants non-nil but nil")
+		}
+	})
 }
@@ -0,0 +1,54 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/int128/ghcp/pkg/git"
+	"github.com/int128/ghcp/pkg/github/client"
+)
+
+type UploadLFSObjectInput struct {
+	Repository git.RepositoryID
+	Object     git.LFSObject
+	Content    io.Reader
+}
+
+// UploadLFSObject uploads the content to the Git LFS storage of the repository.
+// It does nothing if the storage already has the object.
+func (c *GitHub) UploadLFSObject(ctx context.Context, in UploadLFSObjectInput) error {
+	slog.Debug("Requesting Git LFS batch API", "oid", in.Object.OID, "size", in.Object.Size, "repository", in.Repository)
+	object := client.LFSObject{OID: in.Object.OID, Size: in.Object.Size}
+	batch, err := c.Client.BatchLFS(ctx, in.Repository.Owner, in.Repository.Name, client.LFSBatchRequest{
+		Operation: "upload",
+		Transfers: []string{"basic"},
+		Objects:   []client.LFSObject{object},
+		HashAlgo:  "sha256",
+	})
+	if err != nil {
+		return fmt.Errorf("Git LFS API error: %w", err)
+	}
+	if len(batch.Objects) != 1 {
+		return fmt.Errorf("Git LFS API returned %d objects but wants 1", len(batch.Objects))
+	}
+	r := batch.Objects[0]
+	if r.Error != nil {
+		return fmt.Errorf("Git LFS API error: %w", r.Error)
+	}
+	upload, ok := r.Actions["upload"]
+	if !ok {
+		slog.Debug("Git LFS storage already has the object", "oid", in.Object.OID)
+		return nil
+	}
+	if err := c.Client.UploadLFSObject(ctx, upload, in.Content, in.Object.Size); err != nil {
+		return fmt.Errorf("Git LFS upload error: %w", err)
+	}
+	if verify, ok := r.Actions["verify"]; ok {
+		if err := c.Client.VerifyLFSObject(ctx, verify, object); err != nil {
+			return fmt.Errorf("Git LFS verify error: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package github
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/int128/ghcp/mocks/github.com/int128/ghcp/pkg/github/client_mock"
+	"github.com/int128/ghcp/pkg/git"
+	"github.com/int128/ghcp/pkg/github/client"
+)
+
+func TestGitHub_UploadLFSObject(t *testing.T) {
+	ctx := context.TODO()
+	repositoryID := git.RepositoryID{Owner: "owner", Name: "repo"}
+	batchRequest := client.LFSBatchRequest{
+		Operation: "upload",
+		Transfers: []string{"basic"},
+		Objects:   []client.LFSObject{{OID: "OID", Size: 11}},
+		HashAlgo:  "sha256",
+	}
+
+	t.Run("Upload", func(t *testing.T) {
+		content := strings.NewReader("hello world")
+		uploadAction := client.LFSAction{Href: "https://storage.example.com/OID"}
+		verifyAction := client.LFSAction{Href: "https://github.com/owner/repo.git/info/lfs/verify"}
+		gitHubClient := client_mock.NewMockInterface(t)
+		gitHubClient.EXPECT().
+			BatchLFS(ctx, "owner", "repo", batchRequest).
+			Return(&client.LFSBatchResponse{
+				Objects: []client.LFSObjectResponse{{
+					LFSObject: client.LFSObject{OID: "OID", Size: 11},
+					Actions: map[string]client.LFSAction{
+						"upload": uploadAction,
+						"verify": verifyAction,
+					},
+				}},
+			}, nil)
+		gitHubClient.EXPECT().
+			UploadLFSObject(ctx, uploadAction, content, int64(11)).
+			Return(nil)
+		gitHubClient.EXPECT().
+			VerifyLFSObject(ctx, verifyAction, client.LFSObject{OID: "OID", Size: 11}).
+			Return(nil)
+		gitHub := GitHub{Client: gitHubClient}
+		if err := gitHub.UploadLFSObject(ctx, UploadLFSObjectInput{
+			Repository: repositoryID,
+			Object:     git.LFSObject{OID: "OID", Size: 11},
+			Content:    content,
+		}); err != nil {
+			t.Fatalf("UploadLFSObject returned error: %+v", err)
+		}
+	})
+
+	t.Run("AlreadyExists", func(t *testing.T) {
+		gitHubClient := client_mock.NewMockInterface(t)
+		gitHubClient.EXPECT().
+			BatchLFS(ctx, "owner", "repo", batchRequest).
+			Return(&client.LFSBatchResponse{
+				Objects: []client.LFSObjectResponse{{
+					LFSObject: client.LFSObject{OID: "OID", Size: 11},
+				}},
+			}, nil)
+		gitHub := GitHub{Client: gitHubClient}
+		if err := gitHub.UploadLFSObject(ctx, UploadLFSObjectInput{
+			Repository: repositoryID,
+			Object:     git.LFSObject{OID: "OID", Size: 11},
+			Content:    strings.NewReader("hello world"),
+		}); err != nil {
+			t.Fatalf("UploadLFSObject returned error: %+v", err)
+		}
+	})
+
+	t.Run("ObjectError", func(t *testing.T) {
+		gitHubClient := client_mock.NewMockInterface(t)
+		gitHubClient.EXPECT().
+			BatchLFS(ctx, "owner", "repo", batchRequest).
+			Return(&client.LFSBatchResponse{
+				Objects: []client.LFSObjectResponse{{
+					LFSObject: client.LFSObject{OID: "OID", Size: 11},
+					Error:     &client.LFSObjectError{Code: 422, Message: "size exceeds the limit"},
+				}},
+			}, nil)
+		gitHub := GitHub{Client: gitHubClient}
+		err := gitHub.UploadLFSObject(ctx, UploadLFSObjectInput{
+			Repository: repositoryID,
+			Object:     git.LFSObject{OID: "OID", Size: 11},
+			Content:    strings.NewReader("hello world"),
+		})
+		if err == nil {
+			t.Fatalf("err wants non-nil but nil")
+		}
+	})
+}
@@ -0,0 +1,82 @@
+// Package signer provides signing of a commit by an external program, as well as Git.
+package signer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"os/exec"
+	"strings"
+
+	"github.com/google/wire"
+
+	"github.com/int128/ghcp/pkg/git"
+)
+
+var Set = wire.NewSet(
+	wire.Struct(new(Signer), "*"),
+	wire.Bind(new(Interface), new(*Signer)),
+)
+
+type Interface interface {
+	Sign(ctx context.Context, key git.SigningKey, payload []byte) (string, error)
+}
+
+// Signer signs a payload using gpg or ssh-keygen.
+type Signer struct{}
+
+// Sign returns the armored detached signature of the payload.
+func (s *Signer) Sign(ctx context.Context, key git.SigningKey, payload []byte) (string, error) {
+	switch key.Format {
+	case git.SignatureFormatOpenPGP:
+		return signOpenPGP(ctx, key.Key, payload)
+	case git.SignatureFormatSSH:
+		return signSSH(ctx, key.Key, payload)
+	default:
+		return "", fmt.Errorf("unknown signature format %q", key.Format)
+	}
+}
+
+// signOpenPGP runs gpg in the same way as Git.
+// If the key is empty, gpg uses the default key.
+func signOpenPGP(ctx context.Context, key string, payload []byte) (string, error) {
+	args := []string{"--status-fd=2", "--detach-sign", "--armor"}
+	if key != "" {
+		args = append(args, "--local-user", key)
+	}
+	stdout, stderr, err := run(ctx, "gpg", args, payload)
+	if err != nil {
+		return "", err
+	}
+	if !strings.Contains(stderr, "[GNUPG:] SIG_CREATED ") {
+		return "", fmt.Errorf("gpg did not create a signature: %s", stderr)
+	}
+	return stdout, nil
+}
+
+// signSSH runs ssh-keygen in the same way as Git.
+// The key must be a path to the private key, or the public key in the ssh-agent.
+func signSSH(ctx context.Context, key string, payload []byte) (string, error) {
+	if key == "" {
+		return "", fmt.Errorf("path to the SSH key is required")
+	}
+	stdout, _, err := run(ctx, "ssh-keygen", []string{"-Y", "sign", "-n", "git", "-f", key}, payload)
+	if err != nil {
+		return "", err
+	}
+	return stdout, nil
+}
+
+func run(ctx context.Context, name string, args []string, stdin []byte) (string, string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdin = bytes.NewReader(stdin)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	slog.Debug("Running the command to sign", "command", cmd.String())
+	if err := cmd.Run(); err != nil {
+		return "", "", fmt.Errorf("error while running %s: %w: %s", name, err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.String(), stderr.String(), nil
+}
@@ -0,0 +1,50 @@
+package signer
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/int128/ghcp/pkg/git"
+)
+
+func TestSigner_Sign(t *testing.T) {
+	ctx := context.TODO()
+	var s Signer
+
+	t.Run("SSH", func(t *testing.T) {
+		if _, err := exec.LookPath("ssh-keygen"); err != nil {
+			t.Skipf("ssh-keygen is not available: %s", err)
+		}
+		keyFile := filepath.Join(t.TempDir(), "id_ed25519")
+		if out, err := exec.Command("ssh-keygen", "-q", "-t", "ed25519", "-N", "", "-f", keyFile).CombinedOutput(); err != nil {
+			t.Fatalf("could not generate a key: %s: %s", err, out)
+		}
+		signature, err := s.Sign(ctx, git.SigningKey{Format: git.SignatureFormatSSH, Key: keyFile}, []byte("payload"))
+		if err != nil {
+			t.Fatalf("Sign returned error: %+v", err)
+		}
+		if !strings.HasPrefix(signature, "-----BEGIN SSH SIGNATURE-----\n") {
+			t.Errorf("signature wants SSH signature but %s", signature)
+		}
+		signatureFile := filepath.Join(t.TempDir(), "payload.sig")
+		if err := os.WriteFile(signatureFile, []byte(signature), 0600); err != nil {
+			t.Fatalf("WriteFile: %s", err)
+		}
+		verify := exec.Command("ssh-keygen", "-Y", "check-novalidate", "-n", "git", "-s", signatureFile)
+		verify.Stdin = strings.NewReader("payload")
+		if out, err := verify.CombinedOutput(); err != nil {
+			t.Errorf("could not verify the signature: %s: %s", err, out)
+		}
+	})
+
+	t.Run("UnknownFormat", func(t *testing.T) {
+		_, err := s.Sign(ctx, git.SigningKey{Format: "x509", Key: "KEY"}, []byte("payload"))
+		if err == nil {
+			t.Errorf("err wants non-nil but nil")
+		}
+	})
+}
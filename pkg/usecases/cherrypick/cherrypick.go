@@ -0,0 +1,206 @@
+// Package cherrypick provides the use-case to apply the changes of an existing commit onto a branch,
+// or to revert them.
+package cherrypick
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/google/wire"
+
+	"github.com/int128/ghcp/pkg/git"
+	"github.com/int128/ghcp/pkg/git/commitstrategy"
+	"github.com/int128/ghcp/pkg/github"
+	"github.com/int128/ghcp/pkg/usecases/commit"
+	"github.com/int128/ghcp/pkg/usecases/gitobject"
+)
+
+var Set = wire.NewSet(
+	wire.Struct(new(CherryPick), "*"),
+	wire.Bind(new(Interface), new(*CherryPick)),
+)
+
+type Interface interface {
+	Do(ctx context.Context, in Input) (*commit.Output, error)
+}
+
+type Input struct {
+	Repository   git.RepositoryID
+	BranchName   git.BranchName    // if empty, target is the default branch
+	CommitSHA    git.CommitSHA     // commit to cherry-pick
+	RecordOrigin bool              // append "(cherry picked from commit ...)" to the message
+	Revert       bool              // apply the inverse of the changes instead
+	Committer    *git.CommitAuthor // optional
+	DryRun       bool
+}
+
+// ConflictError represents an error that the files have diverged in the branch.
+type ConflictError struct {
+	Filenames []string
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("conflict in %d file(s) changed in the branch: %s", len(e.Filenames), strings.Join(e.Filenames, ", "))
+}
+
+// CherryPick applies the changes of the commit against its parent onto the branch.
+// It creates a commit with the same message and author as the original commit.
+// If Revert is set, it applies the inverse of the changes with a message of the revert.
+type CherryPick struct {
+	Commit commit.Interface
+	GitHub github.Interface
+}
+
+func (u *CherryPick) Do(ctx context.Context, in Input) (*commit.Output, error) {
+	if !in.Repository.IsValid() {
+		return nil, errors.New("you must set GitHub repository")
+	}
+	if in.CommitSHA == "" {
+		return nil, errors.New("you must set the commit to cherry-pick")
+	}
+	source, err := u.GitHub.GetCommit(ctx, in.Repository, in.CommitSHA)
+	if err != nil {
+		return nil, fmt.Errorf("could not find the commit %s: %w", in.CommitSHA, err)
+	}
+	if len(source.Parents) != 1 {
+		return nil, fmt.Errorf("commit %s must have exactly one parent but has %d", in.CommitSHA, len(source.Parents))
+	}
+	parent, err := u.GitHub.QueryCommit(ctx, github.QueryCommitInput{Repository: in.Repository, CommitSHA: source.Parents[0]})
+	if err != nil {
+		return nil, fmt.Errorf("could not find the parent commit %s: %w", source.Parents[0], err)
+	}
+	parentTree, err := u.GitHub.GetTree(ctx, in.Repository, parent.TreeSHA)
+	if err != nil {
+		return nil, fmt.Errorf("could not get the tree of the parent commit: %w", err)
+	}
+	sourceTree, err := u.GitHub.GetTree(ctx, in.Repository, source.TreeSHA)
+	if err != nil {
+		return nil, fmt.Errorf("could not get the tree of the commit: %w", err)
+	}
+	baseTree, headTree := parentTree, sourceTree
+	if in.Revert {
+		baseTree, headTree = sourceTree, parentTree
+	}
+	changes := git.DiffTrees(*baseTree, *headTree)
+	if len(changes) == 0 {
+		return nil, fmt.Errorf("commit %s has no change of regular files", in.CommitSHA)
+	}
+	if in.Revert {
+		slog.Info("Reverting the commit", "commit", source.SHA, "files", len(changes))
+	} else {
+		slog.Info("Cherry-picking the commit", "commit", source.SHA, "files", len(changes))
+	}
+
+	if in.BranchName == "" {
+		q, err := u.GitHub.QueryDefaultBranch(ctx, github.QueryDefaultBranchInput{
+			HeadRepository: in.Repository,
+			BaseRepository: in.Repository,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not determine the default branch: %w", err)
+		}
+		in.BranchName = q.HeadDefaultBranchName
+	}
+	q, err := u.GitHub.QueryForCommit(ctx, github.QueryForCommitInput{
+		ParentRepository: in.Repository,
+		TargetRepository: in.Repository,
+		TargetBranchName: in.BranchName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not find the repository: %w", err)
+	}
+	if !q.TargetBranchExists() {
+		return nil, fmt.Errorf("branch %s does not exist", in.BranchName)
+	}
+	targetTree, err := u.GitHub.GetTree(ctx, in.Repository, q.TargetBranchTreeSHA)
+	if err != nil {
+		return nil, fmt.Errorf("could not get the tree of the branch %s: %w", in.BranchName, err)
+	}
+	changes, err = applicableChanges(changes, *baseTree, *targetTree)
+	if err != nil {
+		return nil, err
+	}
+
+	commitIn := commit.Input{
+		TargetRepository: in.Repository,
+		TargetBranchName: in.BranchName,
+		ParentRepository: in.Repository,
+		CommitStrategy:   commitstrategy.FastForward,
+		CommitMessage:    source.Message,
+		Author:           &source.Author,
+		Committer:        in.Committer,
+		Lease:            q.TargetBranchCommitSHA, // fail if the branch has moved since the conflict check
+		DryRun:           in.DryRun,
+	}
+	switch {
+	case in.Revert:
+		// the author is the current user, as well as git revert
+		commitIn.CommitMessage = revertMessage(*source)
+		commitIn.Author = nil
+	case in.RecordOrigin:
+		commitIn.CommitMessage = git.CommitMessage(fmt.Sprintf("%s\n\n(cherry picked from commit %s)", strings.TrimRight(string(source.Message), "\n"), source.SHA))
+	}
+	if commitIn.Author != nil && commitIn.Committer == nil {
+		// the committer is the current user at now, as well as git cherry-pick.
+		// GitHub would set the author to the committer if it is omitted.
+		currentUser := q.CurrentUser
+		commitIn.Committer = &currentUser
+	}
+	for _, change := range changes {
+		if change.Deleted {
+			commitIn.DeletePaths = append(commitIn.DeletePaths, change.Filename)
+			continue
+		}
+		commitIn.BlobFiles = append(commitIn.BlobFiles, gitobject.File{
+			Path:       change.Filename,
+			Filename:   change.Filename,
+			Executable: change.Executable,
+			BlobSHA:    change.BlobSHA,
+		})
+	}
+	return u.Commit.Do(ctx, commitIn)
+}
+
+// revertMessage returns the message of the revert in the same format as git revert.
+func revertMessage(source git.Commit) git.CommitMessage {
+	subject, _, _ := strings.Cut(string(source.Message), "\n")
+	return git.CommitMessage(fmt.Sprintf("Revert \"%s\"\n\nThis reverts commit %s.", subject, source.SHA))
+}
+
+// applicableChanges returns the changes which can be applied onto the target tree.
+// A change is skipped if the file in the target tree is already same as the change.
+// It returns a ConflictError if the file in the target tree has diverged from the base tree.
+func applicableChanges(changes []git.File, base, target git.Tree) ([]git.File, error) {
+	baseFiles := make(map[string]git.File, len(base.Files))
+	for _, file := range base.Files {
+		baseFiles[file.Filename] = file
+	}
+	targetFiles := make(map[string]git.File, len(target.Files))
+	for _, file := range target.Files {
+		targetFiles[file.Filename] = file
+	}
+	var applicable []git.File
+	var conflicts []string
+	for _, change := range changes {
+		baseFile, inBase := baseFiles[change.Filename]
+		targetFile, inTarget := targetFiles[change.Filename]
+		switch {
+		case change.Deleted && !inTarget, !change.Deleted && inTarget && targetFile == change:
+			slog.Debug("Skip the file already changed in the branch", "filename", change.Filename)
+		case inBase == inTarget && baseFile == targetFile:
+			applicable = append(applicable, change)
+		default:
+			conflicts = append(conflicts, change.Filename)
+		}
+	}
+	if len(conflicts) > 0 {
+		return nil, &ConflictError{Filenames: conflicts}
+	}
+	if len(applicable) == 0 {
+		return nil, errors.New("the changes have already been applied to the branch")
+	}
+	return applicable, nil
+}
@@ -0,0 +1,254 @@
+package cherrypick
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/int128/ghcp/mocks/github.com/int128/ghcp/pkg/github_mock"
+	"github.com/int128/ghcp/mocks/github.com/int128/ghcp/pkg/usecases/commit_mock"
+	"github.com/int128/ghcp/pkg/git"
+	"github.com/int128/ghcp/pkg/git/commitstrategy"
+	"github.com/int128/ghcp/pkg/github"
+	"github.com/int128/ghcp/pkg/usecases/commit"
+	"github.com/int128/ghcp/pkg/usecases/gitobject"
+)
+
+func TestCherryPick_Do(t *testing.T) {
+	ctx := context.TODO()
+	repositoryID := git.RepositoryID{Owner: "owner", Name: "repo"}
+	author := git.CommitAuthor{Name: "bot", Email: "bot@example.com"}
+	currentUser := git.CommitAuthor{Name: "current", Email: "current@example.com"}
+
+	// the commit modifies file1, adds file2, deletes file3 and changes the mode of file4
+	newGitHubMock := func(t *testing.T, targetFiles []git.File) *github_mock.MockInterface {
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			GetCommit(ctx, repositoryID, git.CommitSHA("sourceCommitSHA")).
+			Return(&git.Commit{
+				SHA:       "sourceCommitSHA",
+				Message:   "message\n",
+				Author:    author,
+				Committer: author,
+				Parents:   []git.CommitSHA{"parentCommitSHA"},
+				TreeSHA:   "sourceTreeSHA",
+			}, nil)
+		gitHub.EXPECT().
+			QueryCommit(ctx, github.QueryCommitInput{Repository: repositoryID, CommitSHA: "parentCommitSHA"}).
+			Return(&github.QueryCommitOutput{TreeSHA: "parentTreeSHA"}, nil)
+		gitHub.EXPECT().
+			GetTree(ctx, repositoryID, git.TreeSHA("parentTreeSHA")).
+			Return(&git.Tree{
+				SHA: "parentTreeSHA",
+				Files: []git.File{
+					{Filename: "file1", BlobSHA: "blobSHA1"},
+					{Filename: "file3", BlobSHA: "blobSHA3"},
+					{Filename: "file4", BlobSHA: "blobSHA4"},
+				},
+			}, nil)
+		gitHub.EXPECT().
+			GetTree(ctx, repositoryID, git.TreeSHA("sourceTreeSHA")).
+			Return(&git.Tree{
+				SHA: "sourceTreeSHA",
+				Files: []git.File{
+					{Filename: "file1", BlobSHA: "newBlobSHA1"},
+					{Filename: "file2", BlobSHA: "blobSHA2"},
+					{Filename: "file4", BlobSHA: "blobSHA4", Executable: true},
+				},
+			}, nil)
+		gitHub.EXPECT().
+			QueryForCommit(ctx, github.QueryForCommitInput{
+				ParentRepository: repositoryID,
+				TargetRepository: repositoryID,
+				TargetBranchName: "release",
+			}).
+			Return(&github.QueryForCommitOutput{
+				CurrentUserName:       "current",
+				CurrentUser:           currentUser,
+				TargetBranchCommitSHA: "releaseCommitSHA",
+				TargetBranchTreeSHA:   "releaseTreeSHA",
+			}, nil)
+		gitHub.EXPECT().
+			GetTree(ctx, repositoryID, git.TreeSHA("releaseTreeSHA")).
+			Return(&git.Tree{SHA: "releaseTreeSHA", Files: targetFiles}, nil)
+		return gitHub
+	}
+
+	t.Run("when the files have not diverged, it should commit the changes", func(t *testing.T) {
+		gitHub := newGitHubMock(t, []git.File{
+			{Filename: "file1", BlobSHA: "blobSHA1"},
+			{Filename: "file3", BlobSHA: "blobSHA3"},
+			{Filename: "file4", BlobSHA: "blobSHA4", Executable: true}, // already changed
+			{Filename: "file5", BlobSHA: "blobSHA5"},
+		})
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(ctx, commit.Input{
+				TargetRepository: repositoryID,
+				TargetBranchName: "release",
+				ParentRepository: repositoryID,
+				CommitStrategy:   commitstrategy.FastForward,
+				CommitMessage:    "message\n\n(cherry picked from commit sourceCommitSHA)",
+				Author:           &author,
+				Committer:        &currentUser, // the current user, as well as git cherry-pick
+				Lease:            "releaseCommitSHA",
+				BlobFiles: []gitobject.File{
+					{Path: "file1", Filename: "file1", BlobSHA: "newBlobSHA1"},
+					{Path: "file2", Filename: "file2", BlobSHA: "blobSHA2"},
+				},
+				DeletePaths: []string{"file3"},
+			}).
+			Return(&commit.Output{CommitSHA: "commitSHA"}, nil)
+
+		useCase := CherryPick{
+			Commit: commitUseCase,
+			GitHub: gitHub,
+		}
+		got, err := useCase.Do(ctx, Input{
+			Repository:   repositoryID,
+			BranchName:   "release",
+			CommitSHA:    "sourceCommitSHA",
+			RecordOrigin: true,
+		})
+		if err != nil {
+			t.Fatalf("Do returned error: %+v", err)
+		}
+		want := &commit.Output{CommitSHA: "commitSHA"}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("when the committer is given, it should use it", func(t *testing.T) {
+		committer := git.CommitAuthor{Name: "Bob", Email: "bob@example.com"}
+		gitHub := newGitHubMock(t, []git.File{
+			{Filename: "file1", BlobSHA: "blobSHA1"},
+			{Filename: "file3", BlobSHA: "blobSHA3"},
+			{Filename: "file4", BlobSHA: "blobSHA4"},
+		})
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(ctx, commit.Input{
+				TargetRepository: repositoryID,
+				TargetBranchName: "release",
+				ParentRepository: repositoryID,
+				CommitStrategy:   commitstrategy.FastForward,
+				CommitMessage:    "message\n",
+				Author:           &author,
+				Committer:        &committer,
+				Lease:            "releaseCommitSHA",
+				BlobFiles: []gitobject.File{
+					{Path: "file1", Filename: "file1", BlobSHA: "newBlobSHA1"},
+					{Path: "file2", Filename: "file2", BlobSHA: "blobSHA2"},
+					{Path: "file4", Filename: "file4", Executable: true, BlobSHA: "blobSHA4"},
+				},
+				DeletePaths: []string{"file3"},
+			}).
+			Return(&commit.Output{CommitSHA: "commitSHA"}, nil)
+
+		useCase := CherryPick{
+			Commit: commitUseCase,
+			GitHub: gitHub,
+		}
+		if _, err := useCase.Do(ctx, Input{
+			Repository: repositoryID,
+			BranchName: "release",
+			CommitSHA:  "sourceCommitSHA",
+			Committer:  &committer,
+		}); err != nil {
+			t.Fatalf("Do returned error: %+v", err)
+		}
+	})
+
+	t.Run("when the files have diverged, it should fail with the files", func(t *testing.T) {
+		gitHub := newGitHubMock(t, []git.File{
+			{Filename: "file1", BlobSHA: "anotherBlobSHA1"},
+			{Filename: "file2", BlobSHA: "anotherBlobSHA2"},
+			{Filename: "file4", BlobSHA: "blobSHA4"},
+		})
+		useCase := CherryPick{
+			Commit: commit_mock.NewMockInterface(t),
+			GitHub: gitHub,
+		}
+		_, err := useCase.Do(ctx, Input{
+			Repository: repositoryID,
+			BranchName: "release",
+			CommitSHA:  "sourceCommitSHA",
+		})
+		var conflict *ConflictError
+		if !errors.As(err, &conflict) {
+			t.Fatalf("err wants ConflictError but %+v", err)
+		}
+		want := []string{"file1", "file2"}
+		if diff := cmp.Diff(want, conflict.Filenames); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("when Revert is set, it should commit the inverse of the changes", func(t *testing.T) {
+		gitHub := newGitHubMock(t, []git.File{
+			{Filename: "file1", BlobSHA: "newBlobSHA1"},
+			{Filename: "file2", BlobSHA: "blobSHA2"},
+			{Filename: "file4", BlobSHA: "blobSHA4", Executable: true},
+			{Filename: "file5", BlobSHA: "blobSHA5"},
+		})
+		commitUseCase := commit_mock.NewMockInterface(t)
+		commitUseCase.EXPECT().
+			Do(ctx, commit.Input{
+				TargetRepository: repositoryID,
+				TargetBranchName: "release",
+				ParentRepository: repositoryID,
+				CommitStrategy:   commitstrategy.FastForward,
+				CommitMessage:    "Revert \"message\"\n\nThis reverts commit sourceCommitSHA.",
+				Lease:            "releaseCommitSHA",
+				BlobFiles: []gitobject.File{
+					{Path: "file1", Filename: "file1", BlobSHA: "blobSHA1"},
+					{Path: "file3", Filename: "file3", BlobSHA: "blobSHA3"},
+					{Path: "file4", Filename: "file4", BlobSHA: "blobSHA4"},
+				},
+				DeletePaths: []string{"file2"},
+			}).
+			Return(&commit.Output{CommitSHA: "commitSHA"}, nil)
+
+		useCase := CherryPick{
+			Commit: commitUseCase,
+			GitHub: gitHub,
+		}
+		got, err := useCase.Do(ctx, Input{
+			Repository: repositoryID,
+			BranchName: "release",
+			CommitSHA:  "sourceCommitSHA",
+			Revert:     true,
+		})
+		if err != nil {
+			t.Fatalf("Do returned error: %+v", err)
+		}
+		want := &commit.Output{CommitSHA: "commitSHA"}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("when the commit is a merge commit, it should fail", func(t *testing.T) {
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			GetCommit(ctx, repositoryID, git.CommitSHA("mergeCommitSHA")).
+			Return(&git.Commit{
+				SHA:     "mergeCommitSHA",
+				Parents: []git.CommitSHA{"parentCommitSHA1", "parentCommitSHA2"},
+				TreeSHA: "mergeTreeSHA",
+			}, nil)
+		useCase := CherryPick{
+			Commit: commit_mock.NewMockInterface(t),
+			GitHub: gitHub,
+		}
+		if _, err := useCase.Do(ctx, Input{
+			Repository: repositoryID,
+			BranchName: "release",
+			CommitSHA:  "mergeCommitSHA",
+		}); err == nil {
+			t.Errorf("err wants non-nil but nil")
+		}
+	})
+}
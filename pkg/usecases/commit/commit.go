@@ -7,9 +7,12 @@ import (
 	"fmt"
 	"log/slog"
 	"path/filepath"
+	"slices"
+	"time"
 
 	"github.com/google/wire"
 
+	"github.com/int128/ghcp/pkg/env"
 	"github.com/int128/ghcp/pkg/fs"
 	"github.com/int128/ghcp/pkg/git"
 	"github.com/int128/ghcp/pkg/git/commitstrategy"
@@ -23,7 +26,8 @@ var Set = wire.NewSet(
 )
 
 type Interface interface {
-	Do(ctx context.Context, in Input) error
+	Do(ctx context.Context, in Input) (*Output, error)
+	Plan(ctx context.Context, in Input) (*PlanOutput, error)
 }
 
 type Input struct {
@@ -31,14 +35,38 @@ type Input struct {
 	TargetBranchName git.BranchName // if empty, target is the default branch
 	ParentRepository git.RepositoryID
 	CommitStrategy   commitstrategy.CommitStrategy
+	NewBranchParent  git.CommitSHA // create a new branch from this commit instead of the default branch (optional)
 	CommitMessage    git.CommitMessage
+	MessageTemplate  bool              // render CommitMessage as a Go template
 	Author           *git.CommitAuthor // optional
 	Committer        *git.CommitAuthor // optional
+	SigningKey       *git.SigningKey   // sign the commit if set (optional)
 	Paths            []string          // if empty or nil, create an empty commit
+	BlobFiles        []gitobject.File  // files of the existing blobs in the repository, in addition to Paths (optional)
+	PathMapping      PathMapping       // optional
+	DeletePaths      []string          // paths in the repository to delete (optional)
+	Sync             bool              // delete files under Paths in the branch which do not exist locally
 	NoFileMode       bool
+	Parallelism      int           // number of files to upload concurrently (default: 1)
+	LFS              bool          // upload the files matched to filter=lfs in .gitattributes to Git LFS
+	GitIgnore        bool          // exclude the files ignored by .gitignore
+	Include          []string      // glob patterns of the local files to include (optional)
+	Exclude          []string      // glob patterns of the local files to exclude (optional)
+	ForceUpdate      bool          // update the existing branch even if it is not fast-forward, only if it has not moved since the query
+	Lease            git.CommitSHA // if set, update the branch only if it points to this commit (optional)
+	Retry            int           // number of retries when another commit has been pushed to the branch (default: 0)
+	GraphQL          bool          // create the commit by the createCommitOnBranch mutation if possible
 	DryRun           bool
+}
 
-	ForceUpdate bool //TODO: support force-update as well
+// Output represents the result of the commit.
+type Output struct {
+	Repository    git.RepositoryID
+	BranchName    git.BranchName
+	CommitSHA     git.CommitSHA // empty if the branch is not changed, i.e., nothing to commit or dry-run
+	ChangedFiles  int
+	DeletedFiles  int
+	BranchCreated bool
 }
 
 // Commit commits files to the default/given branch on the repository.
@@ -46,57 +74,271 @@ type Commit struct {
 	CreateGitObject gitobject.Interface
 	FileSystem      fs.Interface
 	GitHub          github.Interface
+	Env             env.Interface
 }
 
-func (u *Commit) Do(ctx context.Context, in Input) error {
+func (u *Commit) Do(ctx context.Context, in Input) (*Output, error) {
 	if !in.TargetRepository.IsValid() {
-		return errors.New("you must set GitHub repository")
+		return nil, errors.New("you must set GitHub repository")
 	}
 	if in.CommitMessage == "" {
-		return errors.New("you must set commit message")
+		return nil, errors.New("you must set commit message")
+	}
+	if in.Sync && len(in.Paths) == 0 {
+		return nil, errors.New("you must set one or more paths to sync")
 	}
 
-	files, err := u.FileSystem.FindFiles(in.Paths, pathFilter{})
+	files, filter, err := u.findFiles(&in)
 	if err != nil {
-		return fmt.Errorf("could not find files: %w", err)
+		return nil, err
 	}
-	if len(in.Paths) > 0 && len(files) == 0 {
-		return errors.New("no file exists in given paths")
+	if in.TargetBranchName == "" {
+		in.TargetBranchName, err = u.queryDefaultBranchName(ctx, in)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	if in.TargetBranchName == "" {
-		q, err := u.GitHub.QueryDefaultBranch(ctx, github.QueryDefaultBranchInput{
-			HeadRepository: in.TargetRepository,
-			BaseRepository: in.ParentRepository, // mandatory but not used
-		})
+	q, err := u.queryForCommit(ctx, in)
+	if err != nil {
+		return nil, fmt.Errorf("could not find the repository: %w", err)
+	}
+	slog.Info("Author and committer", "user", q.CurrentUserName)
+	if in.Lease != "" {
+		if err := checkLease(in, q); err != nil {
+			return nil, err
+		}
+	}
+	if in.GraphQL {
+		if reason := graphQLFallbackReason(in, files, q); reason != "" {
+			slog.Warn("Falling back to the blob and tree API", "reason", reason)
+			in.GraphQL = false
+		}
+	}
+	if q.TargetBranchExists() {
+		out, err := u.updateExistingBranch(ctx, in, files, filter, q)
 		if err != nil {
-			return fmt.Errorf("could not determine the default branch: %w", err)
+			return nil, fmt.Errorf("could not update the existing branch (%s): %w", in.TargetBranchName, err)
 		}
-		in.TargetBranchName = q.HeadDefaultBranchName
+		return out, nil
+	}
+	out, err := u.createNewBranch(ctx, in, files, filter, q)
+	if err != nil {
+		return nil, fmt.Errorf("could not create a branch (%s) based on the default branch: %w", in.TargetBranchName, err)
 	}
+	return out, nil
+}
 
-	q, err := u.GitHub.QueryForCommit(ctx, github.QueryForCommitInput{
+// findFiles returns the local files to commit and the filter of them.
+// It also replaces the paths to delete with the cleaned ones, e.g., docs/ to docs.
+func (u *Commit) findFiles(in *Input) ([]gitobject.File, fs.FindFilesFilter, error) {
+	deletePaths := make([]string, 0, len(in.DeletePaths))
+	for _, deletePath := range in.DeletePaths {
+		p := cleanPath(deletePath)
+		if err := validateFilename(p); err != nil {
+			return nil, nil, fmt.Errorf("invalid path to delete: %w", err)
+		}
+		deletePaths = append(deletePaths, p)
+	}
+	if len(deletePaths) > 0 {
+		in.DeletePaths = deletePaths
+	}
+	filter, err := u.newFilter(*in)
+	if err != nil {
+		return nil, nil, err
+	}
+	localFiles, err := u.FileSystem.FindFiles(in.Paths, filter)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not find files: %w", err)
+	}
+	if len(in.Paths) > 0 && len(localFiles) == 0 {
+		return nil, nil, errors.New("no file exists in given paths")
+	}
+	files, err := in.PathMapping.resolveFiles(localFiles)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not resolve the paths in the repository: %w", err)
+	}
+	if in.LFS {
+		if err := u.markLFSFiles(files); err != nil {
+			return nil, nil, fmt.Errorf("could not determine the files for Git LFS: %w", err)
+		}
+	}
+	files = append(files, in.BlobFiles...)
+	return files, filter, nil
+}
+
+func (u *Commit) queryDefaultBranchName(ctx context.Context, in Input) (git.BranchName, error) {
+	q, err := u.GitHub.QueryDefaultBranch(ctx, github.QueryDefaultBranchInput{
+		HeadRepository: in.TargetRepository,
+		BaseRepository: in.ParentRepository, // mandatory but not used
+	})
+	if err != nil {
+		return "", fmt.Errorf("could not determine the default branch: %w", err)
+	}
+	return q.HeadDefaultBranchName, nil
+}
+
+func (u *Commit) queryForCommit(ctx context.Context, in Input) (*github.QueryForCommitOutput, error) {
+	return u.GitHub.QueryForCommit(ctx, github.QueryForCommitInput{
 		ParentRepository: in.ParentRepository,
-		ParentRef:        in.CommitStrategy.RebaseUpstream(), // valid only if rebase
+		ParentRef:        parentRef(in.CommitStrategy),
 		TargetRepository: in.TargetRepository,
 		TargetBranchName: in.TargetBranchName,
 	})
+}
+
+// parentRef returns the ref to query in the parent repository, or empty if not needed.
+func parentRef(s commitstrategy.CommitStrategy) git.RefName {
+	if s.IsMerge() {
+		return s.MergeRef()
+	}
+	return s.RebaseUpstream() // valid only if rebase
+}
+
+// setParent sets the parent of the new commit by the commit strategy and the current branch.
+// Do and Plan share it so that the plan shows the same parent as the commit.
+// It does not check whether the branch can be updated by fast-forward, because only GitHub can tell
+// whether the parent is a descendant of the branch. See UpdateBranch for the update.
+func (u *Commit) setParent(ctx context.Context, in Input, q *github.QueryForCommitOutput, gitObj *gitobject.Input) error {
+	switch {
+	case in.CommitStrategy.IsFastForward() && q.TargetBranchExists():
+		gitObj.ParentCommitSHA = q.TargetBranchCommitSHA
+		gitObj.ParentTreeSHA = q.TargetBranchTreeSHA
+		gitObj.ParentRepository = in.TargetRepository
+	case in.CommitStrategy.IsFastForward() && in.NewBranchParent != "":
+		parentTreeSHA, err := u.queryNewBranchParentTree(ctx, in)
+		if err != nil {
+			return err
+		}
+		gitObj.ParentCommitSHA = in.NewBranchParent
+		gitObj.ParentTreeSHA = parentTreeSHA
+		gitObj.ParentRepository = in.ParentRepository
+	case in.CommitStrategy.IsFastForward():
+		gitObj.ParentCommitSHA = q.ParentDefaultBranchCommitSHA
+		gitObj.ParentTreeSHA = q.ParentDefaultBranchTreeSHA
+		gitObj.ParentRepository = in.ParentRepository
+	case in.CommitStrategy.IsRebase():
+		gitObj.ParentCommitSHA = q.ParentRefCommitSHA
+		gitObj.ParentTreeSHA = q.ParentRefTreeSHA
+		gitObj.ParentRepository = in.ParentRepository
+	case in.CommitStrategy.IsMerge() && q.TargetBranchExists():
+		if err := setMergeParents(in, q, gitObj); err != nil {
+			return err
+		}
+	case in.CommitStrategy.IsMerge():
+		return fmt.Errorf("branch %s does not exist to merge %s into", in.TargetBranchName, in.CommitStrategy.MergeRef())
+	case in.CommitStrategy.NoParent():
+	default:
+		return fmt.Errorf("unknown commit strategy %+v", in.CommitStrategy)
+	}
+	slog.Debug("Using the parent", "branch", in.TargetBranchName, "strategy", in.CommitStrategy, "parent", gitObj.ParentCommitSHA)
+	return nil
+}
+
+// queryNewBranchParentTree returns the tree of the commit to create a new branch from.
+func (u *Commit) queryNewBranchParentTree(ctx context.Context, in Input) (git.TreeSHA, error) {
+	parent, err := u.GitHub.QueryCommit(ctx, github.QueryCommitInput{
+		Repository: in.ParentRepository,
+		CommitSHA:  in.NewBranchParent,
+	})
 	if err != nil {
-		return fmt.Errorf("could not find the repository: %w", err)
+		return "", fmt.Errorf("could not find the parent commit %s: %w", in.NewBranchParent, err)
 	}
-	slog.Info("Author and committer", "user", q.CurrentUserName)
-	if q.TargetBranchExists() {
-		if err := u.updateExistingBranch(ctx, in, files, q); err != nil {
-			return fmt.Errorf("could not update the existing branch (%s): %w", in.TargetBranchName, err)
+	if parent.TreeSHA == "" {
+		return "", fmt.Errorf("parent commit %s does not exist in the repository %s", in.NewBranchParent, in.ParentRepository)
+	}
+	return parent.TreeSHA, nil
+}
+
+// setMergeParents sets the parents of a merge commit to the target branch and the merged ref.
+// The files are applied onto the tree of the side given by the strategy.
+func setMergeParents(in Input, q *github.QueryForCommitOutput, gitObj *gitobject.Input) error {
+	mergeRef := in.CommitStrategy.MergeRef()
+	if q.ParentRefCommitSHA == "" {
+		return fmt.Errorf("ref %s does not exist in the repository %s", mergeRef, in.ParentRepository)
+	}
+	gitObj.ParentCommitSHA = q.TargetBranchCommitSHA
+	gitObj.MergeParentSHAs = []git.CommitSHA{q.ParentRefCommitSHA}
+	switch in.CommitStrategy.MergeSide() {
+	case commitstrategy.Ours:
+		gitObj.ParentTreeSHA = q.TargetBranchTreeSHA
+		gitObj.ParentRepository = in.TargetRepository
+	case commitstrategy.Theirs:
+		gitObj.ParentTreeSHA = q.ParentRefTreeSHA
+		gitObj.ParentRepository = in.ParentRepository
+	default:
+		return fmt.Errorf("unknown side of merge %q", in.CommitStrategy.MergeSide())
+	}
+	return nil
+}
+
+// newFilter returns the filter of the local files.
+func (u *Commit) newFilter(in Input) (fs.FindFilesFilter, error) {
+	filters := fs.Filters{pathFilter{}}
+	if in.GitIgnore {
+		gitIgnore, err := u.FileSystem.NewGitIgnoreFilter()
+		if err != nil {
+			return nil, fmt.Errorf("could not read .gitignore: %w", err)
+		}
+		filters = append(filters, gitIgnore)
+	}
+	if len(in.Include) > 0 || len(in.Exclude) > 0 {
+		glob := fs.GlobFilter{Include: in.Include, Exclude: in.Exclude}
+		if err := glob.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid glob pattern: %w", err)
 		}
-		return nil
+		filters = append(filters, glob)
 	}
-	if err := u.createNewBranch(ctx, in, files, q); err != nil {
-		return fmt.Errorf("could not create a branch (%s) based on the default branch: %w", in.TargetBranchName, err)
+	return filters, nil
+}
+
+// checkLease returns an error if the branch does not point to the expected commit.
+func checkLease(in Input, q *github.QueryForCommitOutput) error {
+	if !q.TargetBranchExists() {
+		return fmt.Errorf("stale info: branch %s does not exist but expected %s", in.TargetBranchName, in.Lease)
 	}
+	if q.TargetBranchCommitSHA != in.Lease {
+		return fmt.Errorf("stale info: branch %s points to %s but expected %s", in.TargetBranchName, q.TargetBranchCommitSHA, in.Lease)
+	}
+	slog.Debug("The branch points to the expected commit", "branch", in.TargetBranchName, "commit", in.Lease)
 	return nil
 }
 
+// graphQLFallbackReason returns the reason why the createCommitOnBranch mutation cannot be used.
+// It returns an empty string if the mutation can be used.
+func graphQLFallbackReason(in Input, files []gitobject.File, q *github.QueryForCommitOutput) string {
+	switch {
+	case in.DryRun:
+		return "dry-run"
+	case in.CommitStrategy.NoParent():
+		return "no parent"
+	case in.CommitStrategy.IsMerge():
+		return "merge commit"
+	case in.CommitStrategy.KeepLast() > 0:
+		return "rewrite of the history"
+	case in.CommitStrategy.IsRebase() && q.TargetBranchExists():
+		return "rebase of the existing branch"
+	case in.ForceUpdate:
+		return "force update"
+	case in.Author != nil || in.Committer != nil:
+		return "author or committer"
+	case in.SigningKey != nil:
+		return "signing key"
+	}
+	for _, file := range files {
+		if file.BlobSHA != "" {
+			return "blob in the repository"
+		}
+		if file.LFS {
+			return "Git LFS"
+		}
+		if file.Executable && !in.NoFileMode {
+			return "executable file " + file.Path
+		}
+	}
+	return ""
+}
+
 type pathFilter struct{}
 
 func (f pathFilter) SkipDir(path string) bool {
@@ -112,43 +354,63 @@ func (f pathFilter) ExcludeFile(string) bool {
 	return false
 }
 
-func (u *Commit) createNewBranch(ctx context.Context, in Input, files []fs.File, q *github.QueryForCommitOutput) error {
+func (u *Commit) createNewBranch(ctx context.Context, in Input, files []gitobject.File, filter fs.FindFilesFilter, q *github.QueryForCommitOutput) (*Output, error) {
 	gitObj := gitobject.Input{
 		Files:         files,
+		DeletedFiles:  in.DeletePaths,
 		Repository:    in.TargetRepository,
 		CommitMessage: in.CommitMessage,
 		Author:        in.Author,
 		Committer:     in.Committer,
 		NoFileMode:    in.NoFileMode,
+		Parallelism:   in.Parallelism,
+		SigningKey:    in.SigningKey,
 	}
-	switch {
-	case in.CommitStrategy.IsFastForward():
-		slog.Info("Creating a branch", "branch", in.TargetBranchName)
-		gitObj.ParentCommitSHA = q.ParentDefaultBranchCommitSHA
-		gitObj.ParentTreeSHA = q.ParentDefaultBranchTreeSHA
-	case in.CommitStrategy.IsRebase():
-		slog.Info("Creating a branch", "branch", in.TargetBranchName, "ref", in.CommitStrategy.RebaseUpstream())
-		gitObj.ParentCommitSHA = q.ParentRefCommitSHA
-		gitObj.ParentTreeSHA = q.ParentRefTreeSHA
-	case in.CommitStrategy.NoParent():
-		slog.Info("Creating a branch with no parent", "branch", in.TargetBranchName)
-	default:
-		return fmt.Errorf("unknown commit strategy %+v", in.CommitStrategy)
+	if err := u.setParent(ctx, in, q, &gitObj); err != nil {
+		return nil, err
+	}
+	slog.Info("Creating a branch", "branch", in.TargetBranchName, "strategy", in.CommitStrategy)
+	if _, err := u.keepLastCommits(ctx, in, &gitObj); err != nil {
+		return nil, err
+	}
+	if in.Sync {
+		deletedFiles, err := u.findFilesToSyncDelete(ctx, in, files, filter, gitObj.ParentRepository, gitObj.ParentTreeSHA)
+		if err != nil {
+			return nil, fmt.Errorf("error while finding files to delete: %w", err)
+		}
+		gitObj.DeletedFiles = append(slices.Clip(gitObj.DeletedFiles), deletedFiles...)
+	}
+	if in.MessageTemplate {
+		commitMessage, err := u.renderCommitMessage(in, gitObj)
+		if err != nil {
+			return nil, err
+		}
+		gitObj.CommitMessage = commitMessage
 	}
 
-	slog.Debug("Creating a commit", "files", len(gitObj.Files))
+	if in.GraphQL {
+		slog.Debug("Creating a commit by the GraphQL API", "files", len(gitObj.Files), "deletedFiles", len(gitObj.DeletedFiles))
+		commit, err := u.CreateGitObject.CommitOnBranch(ctx, newCommitOnBranchInput(in, gitObj, q.TargetRepositoryNodeID, true))
+		if err != nil {
+			return nil, fmt.Errorf("error while creating a commit: %w", err)
+		}
+		slog.Info("Created a commit", "changedFiles", commit.ChangedFiles, "deletedFiles", commit.DeletedFiles)
+		return newOutput(in, commit, commit.CommitSHA != "", commit.CommitSHA != ""), nil
+	}
+
+	slog.Debug("Creating a commit", "files", len(gitObj.Files), "deletedFiles", len(gitObj.DeletedFiles))
 	commit, err := u.CreateGitObject.Do(ctx, gitObj)
 	if err != nil {
-		return fmt.Errorf("error while creating a commit: %w", err)
+		return nil, fmt.Errorf("error while creating a commit: %w", err)
 	}
-	slog.Info("Created a commit", "changedFiles", commit.ChangedFiles)
-	if len(files) > 0 && commit.ChangedFiles == 0 {
+	slog.Info("Created a commit", "changedFiles", commit.ChangedFiles, "deletedFiles", commit.DeletedFiles)
+	if len(gitObj.Files)+len(gitObj.DeletedFiles) > 0 && commit.ChangedFiles == 0 {
 		slog.Warn("Nothing to commit because the branch has the same file(s)")
-		return nil
+		return newOutput(in, commit, false, false), nil
 	}
 	if in.DryRun {
 		slog.Info("Do not create a branch due to dry-run", "branch", in.TargetBranchName)
-		return nil
+		return newOutput(in, commit, false, false), nil
 	}
 
 	slog.Debug("Creating a branch", "branch", in.TargetBranchName)
@@ -158,60 +420,174 @@ func (u *Commit) createNewBranch(ctx context.Context, in Input, files []fs.File,
 		CommitSHA:        commit.CommitSHA,
 	}
 	if err := u.GitHub.CreateBranch(ctx, createBranchIn); err != nil {
-		return fmt.Errorf("error while creating %s branch: %w", in.TargetBranchName, err)
+		return nil, fmt.Errorf("error while creating %s branch: %w", in.TargetBranchName, err)
 	}
 	slog.Info("Created a branch", "branch", in.TargetBranchName)
-	return nil
+	return newOutput(in, commit, true, true), nil
+}
+
+// retryInterval is the initial interval of retries, doubled on each retry up to 64 times.
+var retryInterval = time.Second
+
+func (u *Commit) updateExistingBranch(ctx context.Context, in Input, files []gitobject.File, filter fs.FindFilesFilter, q *github.QueryForCommitOutput) (*Output, error) {
+	var uploadedBlobs map[string]git.BlobSHA
+	for attempt := 0; ; attempt++ {
+		commit, updated, err := u.commitToExistingBranch(ctx, in, files, filter, q, uploadedBlobs)
+		if err == nil {
+			return newOutput(in, commit, updated, false), nil
+		}
+		if !retryable(in, err) || attempt >= in.Retry {
+			return nil, err
+		}
+		interval := retryInterval << min(attempt, 6)
+		slog.Warn("Retrying because another commit has been pushed to the branch", "branch", in.TargetBranchName, "retry", attempt+1, "interval", interval)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+		if commit != nil {
+			uploadedBlobs = commit.UploadedBlobs
+		}
+		q, err = u.queryForCommit(ctx, in)
+		if err != nil {
+			return nil, fmt.Errorf("could not find the repository: %w", err)
+		}
+		if !q.TargetBranchExists() {
+			return nil, fmt.Errorf("branch %s has been deleted", in.TargetBranchName)
+		}
+	}
+}
+
+// retryable returns true if the commit can be recreated on the new head of the branch.
+// It returns false if the branch must point to the leased commit.
+// If the history is rewritten to keep the last commits, the branch is force-updated only if it has not moved,
+// and then the history can be rewritten from the new head.
+func retryable(in Input, err error) bool {
+	if in.Lease != "" {
+		return false
+	}
+	switch {
+	case errors.Is(err, github.ErrNotFastForward):
+		return in.CommitStrategy.IsFastForward() || in.CommitStrategy.IsMerge()
+	case errors.Is(err, github.ErrBranchMoved):
+		return in.CommitStrategy.KeepLast() > 0 && !in.ForceUpdate
+	}
+	return false
 }
 
-func (u *Commit) updateExistingBranch(ctx context.Context, in Input, files []fs.File, q *github.QueryForCommitOutput) error {
+// commitToExistingBranch creates a commit and updates the branch.
+// It returns true if the branch is updated.
+// If the branch could not be updated, it returns the commit with the error.
+func (u *Commit) commitToExistingBranch(ctx context.Context, in Input, files []gitobject.File, filter fs.FindFilesFilter, q *github.QueryForCommitOutput, uploadedBlobs map[string]git.BlobSHA) (*gitobject.Output, bool, error) {
 	gitObj := gitobject.Input{
 		Files:         files,
+		DeletedFiles:  in.DeletePaths,
 		Repository:    in.TargetRepository,
 		CommitMessage: in.CommitMessage,
 		Author:        in.Author,
 		Committer:     in.Committer,
 		NoFileMode:    in.NoFileMode,
+		Parallelism:   in.Parallelism,
+		SigningKey:    in.SigningKey,
+		UploadedBlobs: uploadedBlobs,
 	}
-	switch {
-	case in.CommitStrategy.IsFastForward():
-		slog.Info("Updating the branch by fast-forward", "branch", in.TargetBranchName)
-		gitObj.ParentCommitSHA = q.TargetBranchCommitSHA
-		gitObj.ParentTreeSHA = q.TargetBranchTreeSHA
-	case in.CommitStrategy.IsRebase():
-		slog.Info("Rebasing the branch", "branch", in.TargetBranchName, "ref", in.CommitStrategy.RebaseUpstream())
-		gitObj.ParentCommitSHA = q.ParentRefCommitSHA
-		gitObj.ParentTreeSHA = q.ParentRefTreeSHA
-	case in.CommitStrategy.NoParent():
-		slog.Info("Updating the branch to a commit with no parent", "branch", in.TargetBranchName)
-	default:
-		return fmt.Errorf("unknown commit strategy %+v", in.CommitStrategy)
+	if err := u.setParent(ctx, in, q, &gitObj); err != nil {
+		return nil, false, err
+	}
+	slog.Info("Updating the branch", "branch", in.TargetBranchName, "strategy", in.CommitStrategy)
+	rewritten, err := u.keepLastCommits(ctx, in, &gitObj)
+	if err != nil {
+		return nil, false, err
+	}
+	if in.Sync {
+		deletedFiles, err := u.findFilesToSyncDelete(ctx, in, files, filter, gitObj.ParentRepository, gitObj.ParentTreeSHA)
+		if err != nil {
+			return nil, false, fmt.Errorf("error while finding files to delete: %w", err)
+		}
+		gitObj.DeletedFiles = append(slices.Clip(gitObj.DeletedFiles), deletedFiles...)
+	}
+	if in.MessageTemplate {
+		commitMessage, err := u.renderCommitMessage(in, gitObj)
+		if err != nil {
+			return nil, false, err
+		}
+		gitObj.CommitMessage = commitMessage
 	}
 
-	slog.Debug("Creating a commit", "files", len(gitObj.Files))
+	if in.GraphQL {
+		slog.Debug("Creating a commit by the GraphQL API", "files", len(gitObj.Files), "deletedFiles", len(gitObj.DeletedFiles))
+		commit, err := u.CreateGitObject.CommitOnBranch(ctx, newCommitOnBranchInput(in, gitObj, q.TargetRepositoryNodeID, false))
+		if err != nil {
+			return nil, false, fmt.Errorf("error while creating a commit: %w", err)
+		}
+		slog.Info("Created a commit", "changedFiles", commit.ChangedFiles, "deletedFiles", commit.DeletedFiles)
+		return commit, commit.CommitSHA != "", nil
+	}
+
+	slog.Debug("Creating a commit", "files", len(gitObj.Files), "deletedFiles", len(gitObj.DeletedFiles))
 	commit, err := u.CreateGitObject.Do(ctx, gitObj)
 	if err != nil {
-		return fmt.Errorf("error while creating a commit: %w", err)
+		return nil, false, fmt.Errorf("error while creating a commit: %w", err)
 	}
-	slog.Info("Created a commit", "changedFiles", commit.ChangedFiles)
-	if len(files) > 0 && commit.ChangedFiles == 0 {
+	slog.Info("Created a commit", "changedFiles", commit.ChangedFiles, "deletedFiles", commit.DeletedFiles)
+	if len(gitObj.Files)+len(gitObj.DeletedFiles) > 0 && commit.ChangedFiles == 0 && !in.CommitStrategy.IsMerge() {
 		slog.Warn("Nothing to commit because the branch has the same file(s)", "branch", in.TargetBranchName)
-		return nil
+		return commit, false, nil
 	}
 	if in.DryRun {
 		slog.Info("Do not update branch due to dry-run", "branch", in.TargetBranchName)
-		return nil
+		return commit, false, nil
 	}
 
 	slog.Debug("Updating the branch", "branch", in.TargetBranchName)
 	updateBranchIn := github.UpdateBranchInput{
 		BranchRefNodeID: q.TargetBranchNodeID,
 		CommitSHA:       commit.CommitSHA,
-		Force:           in.ForceUpdate,
+		Force:           in.ForceUpdate || rewritten,
+	}
+	if updateBranchIn.Force || in.Lease != "" {
+		// fail if another commit has been pushed after the query, as well as git push --force-with-lease
+		updateBranchIn.ExpectedCommitSHA = q.TargetBranchCommitSHA
+		updateBranchIn.RepositoryNodeID = q.TargetRepositoryNodeID
+		updateBranchIn.BranchName = in.TargetBranchName
 	}
 	if err := u.GitHub.UpdateBranch(ctx, updateBranchIn); err != nil {
-		return fmt.Errorf("error while updating %s branch: %w", in.TargetBranchName, err)
+		return commit, false, fmt.Errorf("error while updating %s branch: %w", in.TargetBranchName, err)
 	}
 	slog.Info("Updated the branch", "branch", in.TargetBranchName)
-	return nil
+	return commit, true, nil
+}
+
+// newOutput returns the result of the commit.
+// The commit SHA is set only if the branch is changed.
+func newOutput(in Input, commit *gitobject.Output, changed, created bool) *Output {
+	out := Output{
+		Repository:    in.TargetRepository,
+		BranchName:    in.TargetBranchName,
+		ChangedFiles:  commit.ChangedFiles,
+		DeletedFiles:  commit.DeletedFiles,
+		BranchCreated: created,
+	}
+	if changed {
+		out.CommitSHA = commit.CommitSHA
+	}
+	return &out
+}
+
+// newCommitOnBranchInput returns the input of the createCommitOnBranch mutation.
+// The branch is created at the parent commit if createBranch is set.
+func newCommitOnBranchInput(in Input, gitObj gitobject.Input, repositoryNodeID github.InternalRepositoryNodeID, createBranch bool) gitobject.CommitOnBranchInput {
+	return gitobject.CommitOnBranchInput{
+		Files:            gitObj.Files,
+		DeletedFiles:     gitObj.DeletedFiles,
+		Repository:       gitObj.Repository,
+		RepositoryNodeID: repositoryNodeID,
+		BranchName:       in.TargetBranchName,
+		CreateBranch:     createBranch,
+		CommitMessage:    gitObj.CommitMessage,
+		ParentCommitSHA:  gitObj.ParentCommitSHA,
+		ParentTreeSHA:    gitObj.ParentTreeSHA,
+		ParentRepository: gitObj.ParentRepository,
+	}
 }
@@ -2,8 +2,12 @@ package commit
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/int128/ghcp/mocks/github.com/int128/ghcp/pkg/env_mock"
 	"github.com/int128/ghcp/mocks/github.com/int128/ghcp/pkg/fs_mock"
 	"github.com/int128/ghcp/mocks/github.com/int128/ghcp/pkg/github_mock"
 	"github.com/int128/ghcp/mocks/github.com/int128/ghcp/pkg/usecases/gitobject_mock"
@@ -26,6 +30,10 @@ var theFiles = []fs.File{
 	{Path: "file1"},
 	{Path: "file2", Executable: true},
 }
+var theGitObjectFiles = []gitobject.File{
+	{Path: "file1", Filename: "file1"},
+	{Path: "file2", Filename: "file2", Executable: true},
+}
 
 func newFileSystemMock(t *testing.T) *fs_mock.MockInterface {
 	fileSystem := fs_mock.NewMockInterface(t)
@@ -33,16 +41,17 @@ func newFileSystemMock(t *testing.T) *fs_mock.MockInterface {
 	return fileSystem
 }
 
-func newCreateGitObjectMock(ctx context.Context, t *testing.T, parentCommitSHA git.CommitSHA, parentTreeSHA git.TreeSHA, noFileMode bool, changedFiles int) *gitobject_mock.MockInterface {
+func newCreateGitObjectMock(ctx context.Context, t *testing.T, parentRepository git.RepositoryID, parentCommitSHA git.CommitSHA, parentTreeSHA git.TreeSHA, noFileMode bool, changedFiles int) *gitobject_mock.MockInterface {
 	createGitObject := gitobject_mock.NewMockInterface(t)
 	createGitObject.EXPECT().
 		Do(ctx, gitobject.Input{
-			Files:           theFiles,
-			Repository:      targetRepositoryID,
-			CommitMessage:   "message",
-			ParentCommitSHA: parentCommitSHA,
-			ParentTreeSHA:   parentTreeSHA,
-			NoFileMode:      noFileMode,
+			Files:            theGitObjectFiles,
+			Repository:       targetRepositoryID,
+			CommitMessage:    "message",
+			ParentCommitSHA:  parentCommitSHA,
+			ParentTreeSHA:    parentTreeSHA,
+			ParentRepository: parentRepository,
+			NoFileMode:       noFileMode,
 		}).
 		Return(&gitobject.Output{
 			CommitSHA:    "commitSHA",
@@ -105,11 +114,11 @@ func TestCommitToBranch_Do(t *testing.T) {
 			}
 
 			useCase := Commit{
-				CreateGitObject: newCreateGitObjectMock(ctx, t, "masterCommitSHA", "masterTreeSHA", c.noFileMode, c.changedFiles),
+				CreateGitObject: newCreateGitObjectMock(ctx, t, parentRepositoryID, "masterCommitSHA", "masterTreeSHA", c.noFileMode, c.changedFiles),
 				FileSystem:      newFileSystemMock(t),
 				GitHub:          gitHub,
 			}
-			if err := useCase.Do(ctx, in); err != nil {
+			if _, err := useCase.Do(ctx, in); err != nil {
 				t.Errorf("err wants nil but %+v", err)
 			}
 		})
@@ -152,11 +161,11 @@ func TestCommitToBranch_Do(t *testing.T) {
 				}
 
 				useCase := Commit{
-					CreateGitObject: newCreateGitObjectMock(ctx, t, "masterCommitSHA", "masterTreeSHA", c.noFileMode, c.changedFiles),
+					CreateGitObject: newCreateGitObjectMock(ctx, t, parentRepositoryID, "masterCommitSHA", "masterTreeSHA", c.noFileMode, c.changedFiles),
 					FileSystem:      newFileSystemMock(t),
 					GitHub:          gitHub,
 				}
-				if err := useCase.Do(ctx, in); err != nil {
+				if _, err := useCase.Do(ctx, in); err != nil {
 					t.Errorf("err wants nil but %+v", err)
 				}
 			})
@@ -188,11 +197,11 @@ func TestCommitToBranch_Do(t *testing.T) {
 				}
 
 				useCase := Commit{
-					CreateGitObject: newCreateGitObjectMock(ctx, t, "topicCommitSHA", "topicTreeSHA", c.noFileMode, c.changedFiles),
+					CreateGitObject: newCreateGitObjectMock(ctx, t, targetRepositoryID, "topicCommitSHA", "topicTreeSHA", c.noFileMode, c.changedFiles),
 					FileSystem:      newFileSystemMock(t),
 					GitHub:          gitHub,
 				}
-				if err := useCase.Do(ctx, in); err != nil {
+				if _, err := useCase.Do(ctx, in); err != nil {
 					t.Errorf("err wants nil but %+v", err)
 				}
 			})
@@ -236,11 +245,11 @@ func TestCommitToBranch_Do(t *testing.T) {
 				}
 
 				useCase := Commit{
-					CreateGitObject: newCreateGitObjectMock(ctx, t, "", "", c.noFileMode, c.changedFiles),
+					CreateGitObject: newCreateGitObjectMock(ctx, t, git.RepositoryID{}, "", "", c.noFileMode, c.changedFiles),
 					FileSystem:      newFileSystemMock(t),
 					GitHub:          gitHub,
 				}
-				if err := useCase.Do(ctx, in); err != nil {
+				if _, err := useCase.Do(ctx, in); err != nil {
 					t.Errorf("err wants nil but %+v", err)
 				}
 			})
@@ -272,11 +281,11 @@ func TestCommitToBranch_Do(t *testing.T) {
 				}
 
 				useCase := Commit{
-					CreateGitObject: newCreateGitObjectMock(ctx, t, "", "", c.noFileMode, c.changedFiles),
+					CreateGitObject: newCreateGitObjectMock(ctx, t, git.RepositoryID{}, "", "", c.noFileMode, c.changedFiles),
 					FileSystem:      newFileSystemMock(t),
 					GitHub:          gitHub,
 				}
-				if err := useCase.Do(ctx, in); err != nil {
+				if _, err := useCase.Do(ctx, in); err != nil {
 					t.Errorf("err wants nil but %+v", err)
 				}
 			})
@@ -323,11 +332,11 @@ func TestCommitToBranch_Do(t *testing.T) {
 				}
 
 				useCase := Commit{
-					CreateGitObject: newCreateGitObjectMock(ctx, t, "developCommitSHA", "developTreeSHA", c.noFileMode, c.changedFiles),
+					CreateGitObject: newCreateGitObjectMock(ctx, t, parentRepositoryID, "developCommitSHA", "developTreeSHA", c.noFileMode, c.changedFiles),
 					FileSystem:      newFileSystemMock(t),
 					GitHub:          gitHub,
 				}
-				if err := useCase.Do(ctx, in); err != nil {
+				if _, err := useCase.Do(ctx, in); err != nil {
 					t.Errorf("err wants nil but %+v", err)
 				}
 			})
@@ -362,11 +371,11 @@ func TestCommitToBranch_Do(t *testing.T) {
 				}
 
 				useCase := Commit{
-					CreateGitObject: newCreateGitObjectMock(ctx, t, "developCommitSHA", "developTreeSHA", c.noFileMode, c.changedFiles),
+					CreateGitObject: newCreateGitObjectMock(ctx, t, parentRepositoryID, "developCommitSHA", "developTreeSHA", c.noFileMode, c.changedFiles),
 					FileSystem:      newFileSystemMock(t),
 					GitHub:          gitHub,
 				}
-				if err := useCase.Do(ctx, in); err != nil {
+				if _, err := useCase.Do(ctx, in); err != nil {
 					t.Errorf("err wants nil but %+v", err)
 				}
 			})
@@ -394,6 +403,1575 @@ func TestCommitToBranch_Do(t *testing.T) {
 	}
 }
 
+func TestCommitToBranch_Do_NotFastForward(t *testing.T) {
+	ctx := context.TODO()
+	in := Input{
+		TargetRepository: targetRepositoryID,
+		TargetBranchName: "topic",
+		ParentRepository: parentRepositoryID,
+		CommitStrategy:   commitstrategy.RebaseOn("develop"),
+		CommitMessage:    "message",
+		Paths:            []string{"path"},
+	}
+	gitHub := github_mock.NewMockInterface(t)
+	gitHub.EXPECT().
+		QueryForCommit(ctx, github.QueryForCommitInput{
+			ParentRepository: parentRepositoryID,
+			ParentRef:        "develop",
+			TargetRepository: targetRepositoryID,
+			TargetBranchName: "topic",
+		}).
+		Return(&github.QueryForCommitOutput{
+			CurrentUserName:       "current",
+			TargetBranchNodeID:    targetBranchNodeID,
+			TargetBranchCommitSHA: "topicCommitSHA",
+			TargetBranchTreeSHA:   "topicTreeSHA",
+			ParentRefCommitSHA:    "developCommitSHA",
+			ParentRefTreeSHA:      "developTreeSHA",
+		}, nil)
+	gitHub.EXPECT().
+		UpdateBranch(ctx, github.UpdateBranchInput{
+			BranchRefNodeID: targetBranchNodeID,
+			CommitSHA:       "commitSHA",
+		}).
+		Return(github.ErrNotFastForward)
+
+	useCase := Commit{
+		CreateGitObject: newCreateGitObjectMock(ctx, t, parentRepositoryID, "developCommitSHA", "developTreeSHA", false, 1),
+		FileSystem:      newFileSystemMock(t),
+		GitHub:          gitHub,
+	}
+	_, err := useCase.Do(ctx, in)
+	if !errors.Is(err, github.ErrNotFastForward) {
+		t.Errorf("err wants ErrNotFastForward but %+v", err)
+	}
+}
+
+func TestCommitToBranch_Do_ForceWithLease(t *testing.T) {
+	ctx := context.TODO()
+	in := Input{
+		TargetRepository: targetRepositoryID,
+		TargetBranchName: "topic",
+		ParentRepository: parentRepositoryID,
+		CommitStrategy:   commitstrategy.RebaseOn("develop"),
+		CommitMessage:    "message",
+		Paths:            []string{"path"},
+		ForceUpdate:      true,
+		Lease:            "topicCommitSHA",
+	}
+	queryForCommitIn := github.QueryForCommitInput{
+		ParentRepository: parentRepositoryID,
+		ParentRef:        "develop",
+		TargetRepository: targetRepositoryID,
+		TargetBranchName: "topic",
+	}
+
+	queryForCommitOut := &github.QueryForCommitOutput{
+		CurrentUserName:        "current",
+		TargetRepositoryNodeID: targetRepositoryNodeID,
+		TargetBranchNodeID:     targetBranchNodeID,
+		TargetBranchCommitSHA:  "topicCommitSHA",
+		TargetBranchTreeSHA:    "topicTreeSHA",
+		ParentRefCommitSHA:     "developCommitSHA",
+		ParentRefTreeSHA:       "developTreeSHA",
+	}
+	// the branch is updated only if it still points to the leased commit
+	updateBranchIn := github.UpdateBranchInput{
+		BranchRefNodeID:   targetBranchNodeID,
+		CommitSHA:         "commitSHA",
+		Force:             true,
+		ExpectedCommitSHA: "topicCommitSHA",
+		RepositoryNodeID:  targetRepositoryNodeID,
+		BranchName:        "topic",
+	}
+
+	t.Run("when the branch points to the commit, it should force-update it", func(t *testing.T) {
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			QueryForCommit(ctx, queryForCommitIn).
+			Return(queryForCommitOut, nil)
+		gitHub.EXPECT().
+			UpdateBranch(ctx, updateBranchIn).
+			Return(nil)
+
+		useCase := Commit{
+			CreateGitObject: newCreateGitObjectMock(ctx, t, parentRepositoryID, "developCommitSHA", "developTreeSHA", false, 1),
+			FileSystem:      newFileSystemMock(t),
+			GitHub:          gitHub,
+		}
+		if _, err := useCase.Do(ctx, in); err != nil {
+			t.Errorf("err wants nil but %+v", err)
+		}
+	})
+
+	t.Run("when the branch moved before the update, it should fail", func(t *testing.T) {
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			QueryForCommit(ctx, queryForCommitIn).
+			Return(queryForCommitOut, nil)
+		gitHub.EXPECT().
+			UpdateBranch(ctx, updateBranchIn).
+			Return(errors.New("branch topic may not point to topicCommitSHA"))
+
+		useCase := Commit{
+			CreateGitObject: newCreateGitObjectMock(ctx, t, parentRepositoryID, "developCommitSHA", "developTreeSHA", false, 1),
+			FileSystem:      newFileSystemMock(t),
+			GitHub:          gitHub,
+		}
+		if _, err := useCase.Do(ctx, in); err == nil {
+			t.Errorf("err wants non-nil but nil")
+		}
+	})
+
+	t.Run("when the lease is not set, it should force-update the branch which has not moved", func(t *testing.T) {
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			QueryForCommit(ctx, queryForCommitIn).
+			Return(queryForCommitOut, nil)
+		gitHub.EXPECT().
+			UpdateBranch(ctx, updateBranchIn).
+			Return(nil)
+
+		useCase := Commit{
+			CreateGitObject: newCreateGitObjectMock(ctx, t, parentRepositoryID, "developCommitSHA", "developTreeSHA", false, 1),
+			FileSystem:      newFileSystemMock(t),
+			GitHub:          gitHub,
+		}
+		in := in
+		in.Lease = ""
+		if _, err := useCase.Do(ctx, in); err != nil {
+			t.Errorf("err wants nil but %+v", err)
+		}
+	})
+
+	t.Run("when the branch points to another commit, it should fail", func(t *testing.T) {
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			QueryForCommit(ctx, queryForCommitIn).
+			Return(&github.QueryForCommitOutput{
+				CurrentUserName:       "current",
+				TargetBranchNodeID:    targetBranchNodeID,
+				TargetBranchCommitSHA: "anotherCommitSHA",
+				TargetBranchTreeSHA:   "anotherTreeSHA",
+				ParentRefCommitSHA:    "developCommitSHA",
+				ParentRefTreeSHA:      "developTreeSHA",
+			}, nil)
+
+		useCase := Commit{
+			CreateGitObject: gitobject_mock.NewMockInterface(t),
+			FileSystem:      newFileSystemMock(t),
+			GitHub:          gitHub,
+		}
+		if _, err := useCase.Do(ctx, in); err == nil {
+			t.Errorf("err wants non-nil but nil")
+		}
+	})
+
+	t.Run("when the branch does not exist, it should fail", func(t *testing.T) {
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			QueryForCommit(ctx, queryForCommitIn).
+			Return(&github.QueryForCommitOutput{
+				CurrentUserName:        "current",
+				ParentRefCommitSHA:     "developCommitSHA",
+				ParentRefTreeSHA:       "developTreeSHA",
+				TargetRepositoryNodeID: targetRepositoryNodeID,
+			}, nil)
+
+		useCase := Commit{
+			CreateGitObject: gitobject_mock.NewMockInterface(t),
+			FileSystem:      newFileSystemMock(t),
+			GitHub:          gitHub,
+		}
+		if _, err := useCase.Do(ctx, in); err == nil {
+			t.Errorf("err wants non-nil but nil")
+		}
+	})
+}
+
+func TestCommitToBranch_Do_NewBranchParent(t *testing.T) {
+	ctx := context.TODO()
+	in := Input{
+		TargetRepository: targetRepositoryID,
+		TargetBranchName: "topic",
+		ParentRepository: parentRepositoryID,
+		CommitStrategy:   commitstrategy.FastForward,
+		NewBranchParent:  "workflowCommitSHA",
+		CommitMessage:    "message",
+		Paths:            []string{"path"},
+	}
+	queryForCommitIn := github.QueryForCommitInput{
+		ParentRepository: parentRepositoryID,
+		TargetRepository: targetRepositoryID,
+		TargetBranchName: "topic",
+	}
+
+	t.Run("when a branch does not exist, it should create it from the commit", func(t *testing.T) {
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			QueryForCommit(ctx, queryForCommitIn).
+			Return(&github.QueryForCommitOutput{
+				CurrentUserName:              "current",
+				ParentDefaultBranchCommitSHA: "masterCommitSHA",
+				ParentDefaultBranchTreeSHA:   "masterTreeSHA",
+				TargetRepositoryNodeID:       targetRepositoryNodeID,
+			}, nil)
+		gitHub.EXPECT().
+			QueryCommit(ctx, github.QueryCommitInput{
+				Repository: parentRepositoryID,
+				CommitSHA:  "workflowCommitSHA",
+			}).
+			Return(&github.QueryCommitOutput{TreeSHA: "workflowTreeSHA"}, nil)
+		gitHub.EXPECT().
+			CreateBranch(ctx, github.CreateBranchInput{
+				RepositoryNodeID: targetRepositoryNodeID,
+				BranchName:       "topic",
+				CommitSHA:        "commitSHA",
+			}).
+			Return(nil)
+
+		useCase := Commit{
+			CreateGitObject: newCreateGitObjectMock(ctx, t, parentRepositoryID, "workflowCommitSHA", "workflowTreeSHA", false, 1),
+			FileSystem:      newFileSystemMock(t),
+			GitHub:          gitHub,
+		}
+		got, err := useCase.Do(ctx, in)
+		if err != nil {
+			t.Fatalf("err wants nil but %+v", err)
+		}
+		want := &Output{
+			Repository:    targetRepositoryID,
+			BranchName:    "topic",
+			CommitSHA:     "commitSHA",
+			ChangedFiles:  1,
+			BranchCreated: true,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("when the branch exists, it should update it by fast-forward", func(t *testing.T) {
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			QueryForCommit(ctx, queryForCommitIn).
+			Return(&github.QueryForCommitOutput{
+				CurrentUserName:              "current",
+				ParentDefaultBranchCommitSHA: "masterCommitSHA",
+				ParentDefaultBranchTreeSHA:   "masterTreeSHA",
+				TargetBranchNodeID:           targetBranchNodeID,
+				TargetBranchCommitSHA:        "topicCommitSHA",
+				TargetBranchTreeSHA:          "topicTreeSHA",
+			}, nil)
+		gitHub.EXPECT().
+			UpdateBranch(ctx, github.UpdateBranchInput{
+				BranchRefNodeID: targetBranchNodeID,
+				CommitSHA:       "commitSHA",
+			}).
+			Return(nil)
+
+		useCase := Commit{
+			CreateGitObject: newCreateGitObjectMock(ctx, t, targetRepositoryID, "topicCommitSHA", "topicTreeSHA", false, 1),
+			FileSystem:      newFileSystemMock(t),
+			GitHub:          gitHub,
+		}
+		got, err := useCase.Do(ctx, in)
+		if err != nil {
+			t.Fatalf("err wants nil but %+v", err)
+		}
+		want := &Output{
+			Repository:   targetRepositoryID,
+			BranchName:   "topic",
+			CommitSHA:    "commitSHA",
+			ChangedFiles: 1,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("when the commit does not exist, it should fail", func(t *testing.T) {
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			QueryForCommit(ctx, queryForCommitIn).
+			Return(&github.QueryForCommitOutput{
+				CurrentUserName:              "current",
+				ParentDefaultBranchCommitSHA: "masterCommitSHA",
+				ParentDefaultBranchTreeSHA:   "masterTreeSHA",
+				TargetRepositoryNodeID:       targetRepositoryNodeID,
+			}, nil)
+		gitHub.EXPECT().
+			QueryCommit(ctx, github.QueryCommitInput{
+				Repository: parentRepositoryID,
+				CommitSHA:  "workflowCommitSHA",
+			}).
+			Return(&github.QueryCommitOutput{}, nil)
+
+		useCase := Commit{
+			CreateGitObject: gitobject_mock.NewMockInterface(t),
+			FileSystem:      newFileSystemMock(t),
+			GitHub:          gitHub,
+		}
+		if _, err := useCase.Do(ctx, in); err == nil {
+			t.Errorf("err wants non-nil but nil")
+		}
+	})
+}
+
+func TestCommitToBranch_Do_Merge(t *testing.T) {
+	ctx := context.TODO()
+	queryForCommitIn := github.QueryForCommitInput{
+		ParentRepository: parentRepositoryID,
+		ParentRef:        "release",
+		TargetRepository: targetRepositoryID,
+		TargetBranchName: "topic",
+	}
+	newInput := func(side commitstrategy.MergeSide) Input {
+		return Input{
+			TargetRepository: targetRepositoryID,
+			TargetBranchName: "topic",
+			ParentRepository: parentRepositoryID,
+			CommitStrategy:   commitstrategy.MergeWith("release", side),
+			CommitMessage:    "message",
+			Paths:            []string{"path"},
+		}
+	}
+	newMergeGitObjectMock := func(t *testing.T, parentRepository git.RepositoryID, parentTreeSHA git.TreeSHA) *gitobject_mock.MockInterface {
+		createGitObject := gitobject_mock.NewMockInterface(t)
+		createGitObject.EXPECT().
+			Do(ctx, gitobject.Input{
+				Files:            theGitObjectFiles,
+				Repository:       targetRepositoryID,
+				CommitMessage:    "message",
+				ParentCommitSHA:  "topicCommitSHA",
+				MergeParentSHAs:  []git.CommitSHA{"releaseCommitSHA"},
+				ParentTreeSHA:    parentTreeSHA,
+				ParentRepository: parentRepository,
+			}).
+			Return(&gitobject.Output{CommitSHA: "commitSHA"}, nil)
+		return createGitObject
+	}
+
+	for side, parent := range map[commitstrategy.MergeSide]struct {
+		repository git.RepositoryID
+		treeSHA    git.TreeSHA
+	}{
+		commitstrategy.Ours:   {targetRepositoryID, "topicTreeSHA"},
+		commitstrategy.Theirs: {parentRepositoryID, "releaseTreeSHA"},
+	} {
+		t.Run(fmt.Sprintf("when the tree is %s, it should update the branch to the merge commit", side), func(t *testing.T) {
+			gitHub := github_mock.NewMockInterface(t)
+			gitHub.EXPECT().
+				QueryForCommit(ctx, queryForCommitIn).
+				Return(&github.QueryForCommitOutput{
+					CurrentUserName:       "current",
+					ParentRefCommitSHA:    "releaseCommitSHA",
+					ParentRefTreeSHA:      "releaseTreeSHA",
+					TargetBranchNodeID:    targetBranchNodeID,
+					TargetBranchCommitSHA: "topicCommitSHA",
+					TargetBranchTreeSHA:   "topicTreeSHA",
+				}, nil)
+			gitHub.EXPECT().
+				UpdateBranch(ctx, github.UpdateBranchInput{
+					BranchRefNodeID: targetBranchNodeID,
+					CommitSHA:       "commitSHA",
+				}).
+				Return(nil)
+
+			useCase := Commit{
+				CreateGitObject: newMergeGitObjectMock(t, parent.repository, parent.treeSHA),
+				FileSystem:      newFileSystemMock(t),
+				GitHub:          gitHub,
+			}
+			got, err := useCase.Do(ctx, newInput(side))
+			if err != nil {
+				t.Fatalf("err wants nil but %+v", err)
+			}
+			// the merge commit is created even if the files are same as the tree
+			want := &Output{
+				Repository: targetRepositoryID,
+				BranchName: "topic",
+				CommitSHA:  "commitSHA",
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("when the branch does not exist, it should fail", func(t *testing.T) {
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			QueryForCommit(ctx, queryForCommitIn).
+			Return(&github.QueryForCommitOutput{
+				CurrentUserName:        "current",
+				ParentRefCommitSHA:     "releaseCommitSHA",
+				ParentRefTreeSHA:       "releaseTreeSHA",
+				TargetRepositoryNodeID: targetRepositoryNodeID,
+			}, nil)
+
+		useCase := Commit{
+			CreateGitObject: gitobject_mock.NewMockInterface(t),
+			FileSystem:      newFileSystemMock(t),
+			GitHub:          gitHub,
+		}
+		if _, err := useCase.Do(ctx, newInput(commitstrategy.Ours)); err == nil {
+			t.Errorf("err wants non-nil but nil")
+		}
+	})
+
+	t.Run("when the ref does not exist, it should fail", func(t *testing.T) {
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			QueryForCommit(ctx, queryForCommitIn).
+			Return(&github.QueryForCommitOutput{
+				CurrentUserName:       "current",
+				TargetBranchNodeID:    targetBranchNodeID,
+				TargetBranchCommitSHA: "topicCommitSHA",
+				TargetBranchTreeSHA:   "topicTreeSHA",
+			}, nil)
+
+		useCase := Commit{
+			CreateGitObject: gitobject_mock.NewMockInterface(t),
+			FileSystem:      newFileSystemMock(t),
+			GitHub:          gitHub,
+		}
+		if _, err := useCase.Do(ctx, newInput(commitstrategy.Ours)); err == nil {
+			t.Errorf("err wants non-nil but nil")
+		}
+	})
+}
+
+func TestCommitToBranch_Do_KeepLast(t *testing.T) {
+	ctx := context.TODO()
+	queryForCommitIn := github.QueryForCommitInput{
+		ParentRepository: parentRepositoryID,
+		TargetRepository: targetRepositoryID,
+		TargetBranchName: "topic",
+	}
+	newInput := func(keepLast int) Input {
+		return Input{
+			TargetRepository: targetRepositoryID,
+			TargetBranchName: "topic",
+			ParentRepository: parentRepositoryID,
+			CommitStrategy:   commitstrategy.FastForwardKeepLast(keepLast),
+			CommitMessage:    "message",
+			Paths:            []string{"path"},
+		}
+	}
+	author := git.CommitAuthor{Name: "Alice", Email: "alice@example.com"}
+	// history of the branch: commit1 <- commit2 <- commit3 (head)
+	newGitHubMock := func(t *testing.T, force bool) *github_mock.MockInterface {
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			QueryForCommit(ctx, queryForCommitIn).
+			Return(&github.QueryForCommitOutput{
+				CurrentUserName:        "current",
+				TargetRepositoryNodeID: targetRepositoryNodeID,
+				TargetBranchNodeID:     targetBranchNodeID,
+				TargetBranchCommitSHA:  "commitSHA3",
+				TargetBranchTreeSHA:    "treeSHA3",
+			}, nil)
+		gitHub.EXPECT().
+			GetCommit(ctx, targetRepositoryID, git.CommitSHA("commitSHA3")).
+			Return(&git.Commit{SHA: "commitSHA3", Message: "message3", Author: author, Committer: author, Parents: []git.CommitSHA{"commitSHA2"}, TreeSHA: "treeSHA3"}, nil).
+			Maybe()
+		gitHub.EXPECT().
+			GetCommit(ctx, targetRepositoryID, git.CommitSHA("commitSHA2")).
+			Return(&git.Commit{SHA: "commitSHA2", Message: "message2", Author: author, Committer: author, Parents: []git.CommitSHA{"commitSHA1"}, TreeSHA: "treeSHA2"}, nil).
+			Maybe()
+		gitHub.EXPECT().
+			GetCommit(ctx, targetRepositoryID, git.CommitSHA("commitSHA1")).
+			Return(&git.Commit{SHA: "commitSHA1", Message: "message1", Author: author, Committer: author, TreeSHA: "treeSHA1"}, nil).
+			Maybe()
+		updateBranchIn := github.UpdateBranchInput{
+			BranchRefNodeID: targetBranchNodeID,
+			CommitSHA:       "commitSHA",
+		}
+		if force {
+			// force-update only if the branch has not moved since the query
+			updateBranchIn.Force = true
+			updateBranchIn.ExpectedCommitSHA = "commitSHA3"
+			updateBranchIn.RepositoryNodeID = targetRepositoryNodeID
+			updateBranchIn.BranchName = "topic"
+		}
+		gitHub.EXPECT().
+			UpdateBranch(ctx, updateBranchIn).
+			Return(nil)
+		return gitHub
+	}
+
+	t.Run("when the branch has more commits, it should recreate the last commits", func(t *testing.T) {
+		gitHub := newGitHubMock(t, true)
+		gitHub.EXPECT().
+			CreateCommit(ctx, git.NewCommit{
+				Repository: targetRepositoryID,
+				Message:    "message2",
+				Author:     &author,
+				Committer:  &author,
+				TreeSHA:    "treeSHA2",
+			}).
+			Return("newCommitSHA2", nil)
+		gitHub.EXPECT().
+			CreateCommit(ctx, git.NewCommit{
+				Repository:      targetRepositoryID,
+				Message:         "message3",
+				Author:          &author,
+				Committer:       &author,
+				ParentCommitSHA: "newCommitSHA2",
+				TreeSHA:         "treeSHA3",
+			}).
+			Return("newCommitSHA3", nil)
+
+		useCase := Commit{
+			CreateGitObject: newCreateGitObjectMock(ctx, t, targetRepositoryID, "newCommitSHA3", "treeSHA3", false, 1),
+			FileSystem:      newFileSystemMock(t),
+			GitHub:          gitHub,
+		}
+		if _, err := useCase.Do(ctx, newInput(3)); err != nil {
+			t.Fatalf("err wants nil but %+v", err)
+		}
+	})
+
+	t.Run("when the branch has fewer commits, it should update it by fast-forward", func(t *testing.T) {
+		useCase := Commit{
+			CreateGitObject: newCreateGitObjectMock(ctx, t, targetRepositoryID, "commitSHA3", "treeSHA3", false, 1),
+			FileSystem:      newFileSystemMock(t),
+			GitHub:          newGitHubMock(t, false),
+		}
+		if _, err := useCase.Do(ctx, newInput(4)); err != nil {
+			t.Fatalf("err wants nil but %+v", err)
+		}
+	})
+
+	t.Run("when only the new commit is kept, it should create a commit with no parent", func(t *testing.T) {
+		useCase := Commit{
+			CreateGitObject: newCreateGitObjectMock(ctx, t, targetRepositoryID, "", "treeSHA3", false, 1),
+			FileSystem:      newFileSystemMock(t),
+			GitHub:          newGitHubMock(t, true),
+		}
+		if _, err := useCase.Do(ctx, newInput(1)); err != nil {
+			t.Fatalf("err wants nil but %+v", err)
+		}
+	})
+
+	t.Run("when the branch moved, it should rewrite the history from the new tip on retry", func(t *testing.T) {
+		retryInterval = 0
+		in := newInput(2)
+		in.Retry = 1
+		uploadedBlobs := map[string]git.BlobSHA{"file1": "blobSHA1", "file2": "blobSHA2"}
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			QueryForCommit(ctx, queryForCommitIn).
+			Return(&github.QueryForCommitOutput{
+				CurrentUserName:        "current",
+				TargetRepositoryNodeID: targetRepositoryNodeID,
+				TargetBranchNodeID:     targetBranchNodeID,
+				TargetBranchCommitSHA:  "commitSHA3",
+				TargetBranchTreeSHA:    "treeSHA3",
+			}, nil).
+			Once()
+		gitHub.EXPECT().
+			QueryForCommit(ctx, queryForCommitIn).
+			Return(&github.QueryForCommitOutput{
+				CurrentUserName:        "current",
+				TargetRepositoryNodeID: targetRepositoryNodeID,
+				TargetBranchNodeID:     targetBranchNodeID,
+				TargetBranchCommitSHA:  "commitSHA4",
+				TargetBranchTreeSHA:    "treeSHA4",
+			}, nil).
+			Once()
+		gitHub.EXPECT().
+			GetCommit(ctx, targetRepositoryID, git.CommitSHA("commitSHA3")).
+			Return(&git.Commit{SHA: "commitSHA3", Message: "message3", Author: author, Committer: author, Parents: []git.CommitSHA{"commitSHA2"}, TreeSHA: "treeSHA3"}, nil)
+		gitHub.EXPECT().
+			GetCommit(ctx, targetRepositoryID, git.CommitSHA("commitSHA4")).
+			Return(&git.Commit{SHA: "commitSHA4", Message: "message4", Author: author, Committer: author, Parents: []git.CommitSHA{"commitSHA3"}, TreeSHA: "treeSHA4"}, nil)
+		gitHub.EXPECT().
+			CreateCommit(ctx, git.NewCommit{
+				Repository: targetRepositoryID,
+				Message:    "message3",
+				Author:     &author,
+				Committer:  &author,
+				TreeSHA:    "treeSHA3",
+			}).
+			Return("newCommitSHA3", nil)
+		gitHub.EXPECT().
+			CreateCommit(ctx, git.NewCommit{
+				Repository: targetRepositoryID,
+				Message:    "message4",
+				Author:     &author,
+				Committer:  &author,
+				TreeSHA:    "treeSHA4",
+			}).
+			Return("newCommitSHA4", nil)
+		gitHub.EXPECT().
+			UpdateBranch(ctx, github.UpdateBranchInput{
+				BranchRefNodeID:   targetBranchNodeID,
+				CommitSHA:         "commitSHA",
+				Force:             true,
+				ExpectedCommitSHA: "commitSHA3",
+				RepositoryNodeID:  targetRepositoryNodeID,
+				BranchName:        "topic",
+			}).
+			Return(fmt.Errorf("GitHub API error: %w", github.ErrBranchMoved))
+		gitHub.EXPECT().
+			UpdateBranch(ctx, github.UpdateBranchInput{
+				BranchRefNodeID:   targetBranchNodeID,
+				CommitSHA:         "newCommitSHA",
+				Force:             true,
+				ExpectedCommitSHA: "commitSHA4",
+				RepositoryNodeID:  targetRepositoryNodeID,
+				BranchName:        "topic",
+			}).
+			Return(nil)
+		createGitObject := gitobject_mock.NewMockInterface(t)
+		createGitObject.EXPECT().
+			Do(ctx, gitobject.Input{
+				Files:            theGitObjectFiles,
+				Repository:       targetRepositoryID,
+				CommitMessage:    "message",
+				ParentCommitSHA:  "newCommitSHA3",
+				ParentTreeSHA:    "treeSHA3",
+				ParentRepository: targetRepositoryID,
+			}).
+			Return(&gitobject.Output{
+				CommitSHA:     "commitSHA",
+				ChangedFiles:  2,
+				UploadedBlobs: uploadedBlobs,
+			}, nil)
+		createGitObject.EXPECT().
+			Do(ctx, gitobject.Input{
+				Files:            theGitObjectFiles,
+				Repository:       targetRepositoryID,
+				CommitMessage:    "message",
+				ParentCommitSHA:  "newCommitSHA4",
+				ParentTreeSHA:    "treeSHA4",
+				ParentRepository: targetRepositoryID,
+				UploadedBlobs:    uploadedBlobs,
+			}).
+			Return(&gitobject.Output{
+				CommitSHA:    "newCommitSHA",
+				ChangedFiles: 2,
+			}, nil)
+
+		useCase := Commit{
+			CreateGitObject: createGitObject,
+			FileSystem:      newFileSystemMock(t),
+			GitHub:          gitHub,
+		}
+		if _, err := useCase.Do(ctx, in); err != nil {
+			t.Errorf("err wants nil but %+v", err)
+		}
+	})
+
+	t.Run("when the branch moved with the force update, it should not retry", func(t *testing.T) {
+		in := newInput(2)
+		in.Retry = 1
+		in.ForceUpdate = true
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			QueryForCommit(ctx, queryForCommitIn).
+			Return(&github.QueryForCommitOutput{
+				CurrentUserName:        "current",
+				TargetRepositoryNodeID: targetRepositoryNodeID,
+				TargetBranchNodeID:     targetBranchNodeID,
+				TargetBranchCommitSHA:  "commitSHA3",
+				TargetBranchTreeSHA:    "treeSHA3",
+			}, nil).
+			Once()
+		gitHub.EXPECT().
+			GetCommit(ctx, targetRepositoryID, git.CommitSHA("commitSHA3")).
+			Return(&git.Commit{SHA: "commitSHA3", Message: "message3", Author: author, Committer: author, Parents: []git.CommitSHA{"commitSHA2"}, TreeSHA: "treeSHA3"}, nil)
+		gitHub.EXPECT().
+			CreateCommit(ctx, mock.Anything).
+			Return("newCommitSHA3", nil)
+		gitHub.EXPECT().
+			UpdateBranch(ctx, mock.Anything).
+			Return(fmt.Errorf("GitHub API error: %w", github.ErrBranchMoved)).
+			Once()
+
+		useCase := Commit{
+			CreateGitObject: newCreateGitObjectMock(ctx, t, targetRepositoryID, "newCommitSHA3", "treeSHA3", false, 1),
+			FileSystem:      newFileSystemMock(t),
+			GitHub:          gitHub,
+		}
+		if _, err := useCase.Do(ctx, in); !errors.Is(err, github.ErrBranchMoved) {
+			t.Errorf("err wants ErrBranchMoved but %+v", err)
+		}
+	})
+}
+
+func TestCommitToBranch_Do_Retry(t *testing.T) {
+	ctx := context.TODO()
+	retryInterval = 0
+	in := Input{
+		TargetRepository: targetRepositoryID,
+		TargetBranchName: "topic",
+		ParentRepository: parentRepositoryID,
+		CommitStrategy:   commitstrategy.FastForward,
+		CommitMessage:    "message",
+		Paths:            []string{"path"},
+		Retry:            1,
+	}
+	queryForCommitIn := github.QueryForCommitInput{
+		ParentRepository: parentRepositoryID,
+		TargetRepository: targetRepositoryID,
+		TargetBranchName: "topic",
+	}
+	uploadedBlobs := map[string]git.BlobSHA{"file1": "blobSHA1", "file2": "blobSHA2"}
+
+	t.Run("when the branch moved, it should retry on the new tip", func(t *testing.T) {
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			QueryForCommit(ctx, queryForCommitIn).
+			Return(&github.QueryForCommitOutput{
+				CurrentUserName:       "current",
+				TargetBranchNodeID:    targetBranchNodeID,
+				TargetBranchCommitSHA: "topicCommitSHA",
+				TargetBranchTreeSHA:   "topicTreeSHA",
+			}, nil).
+			Once()
+		gitHub.EXPECT().
+			QueryForCommit(ctx, queryForCommitIn).
+			Return(&github.QueryForCommitOutput{
+				CurrentUserName:       "current",
+				TargetBranchNodeID:    targetBranchNodeID,
+				TargetBranchCommitSHA: "newTopicCommitSHA",
+				TargetBranchTreeSHA:   "newTopicTreeSHA",
+			}, nil).
+			Once()
+		gitHub.EXPECT().
+			UpdateBranch(ctx, github.UpdateBranchInput{
+				BranchRefNodeID: targetBranchNodeID,
+				CommitSHA:       "commitSHA",
+			}).
+			Return(fmt.Errorf("GitHub API error: %w", github.ErrNotFastForward)).
+			Once()
+		gitHub.EXPECT().
+			UpdateBranch(ctx, github.UpdateBranchInput{
+				BranchRefNodeID: targetBranchNodeID,
+				CommitSHA:       "newCommitSHA",
+			}).
+			Return(nil).
+			Once()
+		createGitObject := gitobject_mock.NewMockInterface(t)
+		createGitObject.EXPECT().
+			Do(ctx, gitobject.Input{
+				Files:            theGitObjectFiles,
+				Repository:       targetRepositoryID,
+				CommitMessage:    "message",
+				ParentCommitSHA:  "topicCommitSHA",
+				ParentTreeSHA:    "topicTreeSHA",
+				ParentRepository: targetRepositoryID,
+			}).
+			Return(&gitobject.Output{
+				CommitSHA:     "commitSHA",
+				ChangedFiles:  2,
+				UploadedBlobs: uploadedBlobs,
+			}, nil)
+		createGitObject.EXPECT().
+			Do(ctx, gitobject.Input{
+				Files:            theGitObjectFiles,
+				Repository:       targetRepositoryID,
+				CommitMessage:    "message",
+				ParentCommitSHA:  "newTopicCommitSHA",
+				ParentTreeSHA:    "newTopicTreeSHA",
+				ParentRepository: targetRepositoryID,
+				UploadedBlobs:    uploadedBlobs,
+			}).
+			Return(&gitobject.Output{
+				CommitSHA:     "newCommitSHA",
+				ChangedFiles:  2,
+				UploadedBlobs: uploadedBlobs,
+			}, nil)
+
+		useCase := Commit{
+			CreateGitObject: createGitObject,
+			FileSystem:      newFileSystemMock(t),
+			GitHub:          gitHub,
+		}
+		if _, err := useCase.Do(ctx, in); err != nil {
+			t.Errorf("err wants nil but %+v", err)
+		}
+	})
+
+	t.Run("when the branch moved again, it should fail", func(t *testing.T) {
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			QueryForCommit(ctx, queryForCommitIn).
+			Return(&github.QueryForCommitOutput{
+				CurrentUserName:       "current",
+				TargetBranchNodeID:    targetBranchNodeID,
+				TargetBranchCommitSHA: "topicCommitSHA",
+				TargetBranchTreeSHA:   "topicTreeSHA",
+			}, nil).
+			Times(2)
+		gitHub.EXPECT().
+			UpdateBranch(ctx, github.UpdateBranchInput{
+				BranchRefNodeID: targetBranchNodeID,
+				CommitSHA:       "commitSHA",
+			}).
+			Return(fmt.Errorf("GitHub API error: %w", github.ErrNotFastForward)).
+			Times(2)
+		createGitObject := gitobject_mock.NewMockInterface(t)
+		createGitObject.EXPECT().
+			Do(ctx, mock.Anything).
+			Return(&gitobject.Output{
+				CommitSHA:     "commitSHA",
+				ChangedFiles:  2,
+				UploadedBlobs: uploadedBlobs,
+			}, nil).
+			Times(2)
+
+		useCase := Commit{
+			CreateGitObject: createGitObject,
+			FileSystem:      newFileSystemMock(t),
+			GitHub:          gitHub,
+		}
+		if _, err := useCase.Do(ctx, in); !errors.Is(err, github.ErrNotFastForward) {
+			t.Errorf("err wants ErrNotFastForward but %+v", err)
+		}
+	})
+}
+
+func TestCommitToBranch_Do_GraphQL(t *testing.T) {
+	ctx := context.TODO()
+	in := Input{
+		TargetRepository: targetRepositoryID,
+		TargetBranchName: "topic",
+		ParentRepository: parentRepositoryID,
+		CommitStrategy:   commitstrategy.FastForward,
+		CommitMessage:    "message",
+		Paths:            []string{"path"},
+		NoFileMode:       true,
+		GraphQL:          true,
+	}
+	queryForCommitIn := github.QueryForCommitInput{
+		ParentRepository: parentRepositoryID,
+		TargetRepository: targetRepositoryID,
+		TargetBranchName: "topic",
+	}
+
+	t.Run("when the branch exists, it should commit on the branch", func(t *testing.T) {
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			QueryForCommit(ctx, queryForCommitIn).
+			Return(&github.QueryForCommitOutput{
+				CurrentUserName:        "current",
+				TargetRepositoryNodeID: targetRepositoryNodeID,
+				TargetBranchNodeID:     targetBranchNodeID,
+				TargetBranchCommitSHA:  "topicCommitSHA",
+				TargetBranchTreeSHA:    "topicTreeSHA",
+			}, nil)
+		createGitObject := gitobject_mock.NewMockInterface(t)
+		createGitObject.EXPECT().
+			CommitOnBranch(ctx, gitobject.CommitOnBranchInput{
+				Files:            theGitObjectFiles,
+				Repository:       targetRepositoryID,
+				RepositoryNodeID: targetRepositoryNodeID,
+				BranchName:       "topic",
+				CommitMessage:    "message",
+				ParentCommitSHA:  "topicCommitSHA",
+				ParentTreeSHA:    "topicTreeSHA",
+				ParentRepository: targetRepositoryID,
+			}).
+			Return(&gitobject.Output{
+				CommitSHA:    "commitSHA",
+				ChangedFiles: 2,
+			}, nil)
+
+		useCase := Commit{
+			CreateGitObject: createGitObject,
+			FileSystem:      newFileSystemMock(t),
+			GitHub:          gitHub,
+		}
+		if _, err := useCase.Do(ctx, in); err != nil {
+			t.Errorf("err wants nil but %+v", err)
+		}
+	})
+
+	t.Run("when the branch does not exist, it should create the branch", func(t *testing.T) {
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			QueryForCommit(ctx, queryForCommitIn).
+			Return(&github.QueryForCommitOutput{
+				CurrentUserName:              "current",
+				TargetRepositoryNodeID:       targetRepositoryNodeID,
+				ParentDefaultBranchCommitSHA: "masterCommitSHA",
+				ParentDefaultBranchTreeSHA:   "masterTreeSHA",
+			}, nil)
+		createGitObject := gitobject_mock.NewMockInterface(t)
+		createGitObject.EXPECT().
+			CommitOnBranch(ctx, gitobject.CommitOnBranchInput{
+				Files:            theGitObjectFiles,
+				Repository:       targetRepositoryID,
+				RepositoryNodeID: targetRepositoryNodeID,
+				BranchName:       "topic",
+				CreateBranch:     true,
+				CommitMessage:    "message",
+				ParentCommitSHA:  "masterCommitSHA",
+				ParentTreeSHA:    "masterTreeSHA",
+				ParentRepository: parentRepositoryID,
+			}).
+			Return(&gitobject.Output{
+				CommitSHA:    "commitSHA",
+				ChangedFiles: 2,
+			}, nil)
+
+		useCase := Commit{
+			CreateGitObject: createGitObject,
+			FileSystem:      newFileSystemMock(t),
+			GitHub:          gitHub,
+		}
+		if _, err := useCase.Do(ctx, in); err != nil {
+			t.Errorf("err wants nil but %+v", err)
+		}
+	})
+
+	t.Run("when a file is executable, it should fall back to the blob and tree API", func(t *testing.T) {
+		in := in
+		in.NoFileMode = false
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			QueryForCommit(ctx, queryForCommitIn).
+			Return(&github.QueryForCommitOutput{
+				CurrentUserName:       "current",
+				TargetBranchNodeID:    targetBranchNodeID,
+				TargetBranchCommitSHA: "topicCommitSHA",
+				TargetBranchTreeSHA:   "topicTreeSHA",
+			}, nil)
+		gitHub.EXPECT().
+			UpdateBranch(ctx, github.UpdateBranchInput{
+				BranchRefNodeID: targetBranchNodeID,
+				CommitSHA:       "commitSHA",
+			}).
+			Return(nil)
+
+		useCase := Commit{
+			CreateGitObject: newCreateGitObjectMock(ctx, t, targetRepositoryID, "topicCommitSHA", "topicTreeSHA", false, 1),
+			FileSystem:      newFileSystemMock(t),
+			GitHub:          gitHub,
+		}
+		if _, err := useCase.Do(ctx, in); err != nil {
+			t.Errorf("err wants nil but %+v", err)
+		}
+	})
+}
+
+func TestCommitToBranch_Do_MessageTemplate(t *testing.T) {
+	ctx := context.TODO()
+	in := Input{
+		TargetRepository: targetRepositoryID,
+		TargetBranchName: "topic",
+		ParentRepository: parentRepositoryID,
+		CommitStrategy:   commitstrategy.FastForward,
+		CommitMessage:    `Update {{ join .Files ", " }} on {{ .Branch }} from {{ .ParentSHA }} by {{ env "GHCP_VAR_RUN_ID" }}`,
+		MessageTemplate:  true,
+		Paths:            []string{"path"},
+	}
+	gitHub := github_mock.NewMockInterface(t)
+	gitHub.EXPECT().
+		QueryForCommit(ctx, github.QueryForCommitInput{
+			ParentRepository: parentRepositoryID,
+			TargetRepository: targetRepositoryID,
+			TargetBranchName: "topic",
+		}).
+		Return(&github.QueryForCommitOutput{
+			CurrentUserName:       "current",
+			TargetBranchNodeID:    targetBranchNodeID,
+			TargetBranchCommitSHA: "topicCommitSHA",
+			TargetBranchTreeSHA:   "topicTreeSHA",
+		}, nil)
+	gitHub.EXPECT().
+		UpdateBranch(ctx, github.UpdateBranchInput{
+			BranchRefNodeID: targetBranchNodeID,
+			CommitSHA:       "commitSHA",
+		}).
+		Return(nil)
+	createGitObject := gitobject_mock.NewMockInterface(t)
+	createGitObject.EXPECT().
+		Do(ctx, gitobject.Input{
+			Files:            theGitObjectFiles,
+			Repository:       targetRepositoryID,
+			CommitMessage:    "Update file1, file2 on topic from topicCommitSHA by 12345",
+			ParentCommitSHA:  "topicCommitSHA",
+			ParentTreeSHA:    "topicTreeSHA",
+			ParentRepository: targetRepositoryID,
+		}).
+		Return(&gitobject.Output{
+			CommitSHA:    "commitSHA",
+			ChangedFiles: 2,
+		}, nil)
+	mockEnv := env_mock.NewMockInterface(t)
+	mockEnv.EXPECT().Getenv("GHCP_VAR_RUN_ID").Return("12345")
+
+	useCase := Commit{
+		CreateGitObject: createGitObject,
+		FileSystem:      newFileSystemMock(t),
+		GitHub:          gitHub,
+		Env:             mockEnv,
+	}
+	if _, err := useCase.Do(ctx, in); err != nil {
+		t.Errorf("err wants nil but %+v", err)
+	}
+}
+
+func TestCommitToBranch_Do_MessageTemplateWithDisallowedEnv(t *testing.T) {
+	ctx := context.TODO()
+	in := Input{
+		TargetRepository: targetRepositoryID,
+		TargetBranchName: "topic",
+		ParentRepository: parentRepositoryID,
+		CommitStrategy:   commitstrategy.FastForward,
+		CommitMessage:    `Update by {{ env "GITHUB_TOKEN" }}`,
+		MessageTemplate:  true,
+		Paths:            []string{"path"},
+	}
+	gitHub := github_mock.NewMockInterface(t)
+	gitHub.EXPECT().
+		QueryForCommit(ctx, github.QueryForCommitInput{
+			ParentRepository: parentRepositoryID,
+			TargetRepository: targetRepositoryID,
+			TargetBranchName: "topic",
+		}).
+		Return(&github.QueryForCommitOutput{
+			CurrentUserName:       "current",
+			TargetBranchNodeID:    targetBranchNodeID,
+			TargetBranchCommitSHA: "topicCommitSHA",
+			TargetBranchTreeSHA:   "topicTreeSHA",
+		}, nil)
+
+	useCase := Commit{
+		CreateGitObject: gitobject_mock.NewMockInterface(t),
+		FileSystem:      newFileSystemMock(t),
+		GitHub:          gitHub,
+		Env:             env_mock.NewMockInterface(t),
+	}
+	if _, err := useCase.Do(ctx, in); err == nil {
+		t.Errorf("err wants non-nil but nil")
+	}
+}
+
+func TestCommitToBranch_Do_DeletePaths(t *testing.T) {
+	ctx := context.TODO()
+	in := Input{
+		TargetRepository: targetRepositoryID,
+		TargetBranchName: "topic",
+		ParentRepository: parentRepositoryID,
+		CommitStrategy:   commitstrategy.FastForward,
+		CommitMessage:    "message",
+		DeletePaths:      []string{"file3", "docs/", "./generated"},
+	}
+	fileSystem := fs_mock.NewMockInterface(t)
+	fileSystem.EXPECT().FindFiles([]string(nil), thePathFilter).Return(nil, nil)
+	gitHub := github_mock.NewMockInterface(t)
+	gitHub.EXPECT().
+		QueryForCommit(ctx, github.QueryForCommitInput{
+			ParentRepository: parentRepositoryID,
+			TargetRepository: targetRepositoryID,
+			TargetBranchName: "topic",
+		}).
+		Return(&github.QueryForCommitOutput{
+			CurrentUserName:       "current",
+			TargetBranchNodeID:    targetBranchNodeID,
+			TargetBranchCommitSHA: "topicCommitSHA",
+			TargetBranchTreeSHA:   "topicTreeSHA",
+		}, nil)
+	gitHub.EXPECT().
+		UpdateBranch(ctx, github.UpdateBranchInput{
+			BranchRefNodeID: targetBranchNodeID,
+			CommitSHA:       "commitSHA",
+		}).
+		Return(nil)
+	createGitObject := gitobject_mock.NewMockInterface(t)
+	createGitObject.EXPECT().
+		Do(ctx, gitobject.Input{
+			DeletedFiles:     []string{"file3", "docs", "generated"}, // cleaned paths
+			Repository:       targetRepositoryID,
+			CommitMessage:    "message",
+			ParentCommitSHA:  "topicCommitSHA",
+			ParentTreeSHA:    "topicTreeSHA",
+			ParentRepository: targetRepositoryID,
+		}).
+		Return(&gitobject.Output{
+			CommitSHA:    "commitSHA",
+			ChangedFiles: 1,
+			DeletedFiles: 1,
+		}, nil)
+
+	useCase := Commit{
+		CreateGitObject: createGitObject,
+		FileSystem:      fileSystem,
+		GitHub:          gitHub,
+	}
+	if _, err := useCase.Do(ctx, in); err != nil {
+		t.Errorf("err wants nil but %+v", err)
+	}
+}
+
+func TestCommitToBranch_Do_LFS(t *testing.T) {
+	ctx := context.TODO()
+	in := Input{
+		TargetRepository: targetRepositoryID,
+		TargetBranchName: "topic",
+		ParentRepository: parentRepositoryID,
+		CommitStrategy:   commitstrategy.FastForward,
+		CommitMessage:    "message",
+		Paths:            []string{"path"},
+		LFS:              true,
+	}
+	fileSystem := fs_mock.NewMockInterface(t)
+	fileSystem.EXPECT().FindFiles([]string{"path"}, thePathFilter).Return([]fs.File{
+		{Path: "path/file1"},
+		{Path: "path/file2.bin", Size: 100},
+	}, nil)
+	fileSystem.EXPECT().ReadLFSRules(".gitattributes").Return(fs.LFSRules{
+		{Pattern: "*.bin", LFS: true},
+	}, nil)
+	gitHub := github_mock.NewMockInterface(t)
+	gitHub.EXPECT().
+		QueryForCommit(ctx, github.QueryForCommitInput{
+			ParentRepository: parentRepositoryID,
+			TargetRepository: targetRepositoryID,
+			TargetBranchName: "topic",
+		}).
+		Return(&github.QueryForCommitOutput{
+			CurrentUserName:       "current",
+			TargetBranchNodeID:    targetBranchNodeID,
+			TargetBranchCommitSHA: "topicCommitSHA",
+			TargetBranchTreeSHA:   "topicTreeSHA",
+		}, nil)
+	gitHub.EXPECT().
+		UpdateBranch(ctx, github.UpdateBranchInput{
+			BranchRefNodeID: targetBranchNodeID,
+			CommitSHA:       "commitSHA",
+		}).
+		Return(nil)
+	createGitObject := gitobject_mock.NewMockInterface(t)
+	createGitObject.EXPECT().
+		Do(ctx, gitobject.Input{
+			Files: []gitobject.File{
+				{Path: "path/file1", Filename: "path/file1"},
+				{Path: "path/file2.bin", Filename: "path/file2.bin", Size: 100, LFS: true},
+			},
+			Repository:       targetRepositoryID,
+			CommitMessage:    "message",
+			ParentCommitSHA:  "topicCommitSHA",
+			ParentTreeSHA:    "topicTreeSHA",
+			ParentRepository: targetRepositoryID,
+		}).
+		Return(&gitobject.Output{
+			CommitSHA:    "commitSHA",
+			ChangedFiles: 2,
+		}, nil)
+
+	useCase := Commit{
+		CreateGitObject: createGitObject,
+		FileSystem:      fileSystem,
+		GitHub:          gitHub,
+	}
+	if _, err := useCase.Do(ctx, in); err != nil {
+		t.Errorf("err wants nil but %+v", err)
+	}
+}
+
+func TestCommitToBranch_Do_Sync(t *testing.T) {
+	ctx := context.TODO()
+	in := Input{
+		TargetRepository: targetRepositoryID,
+		TargetBranchName: "topic",
+		ParentRepository: parentRepositoryID,
+		CommitStrategy:   commitstrategy.FastForward,
+		CommitMessage:    "message",
+		Paths:            []string{"path"},
+		Sync:             true,
+	}
+	fileSystem := fs_mock.NewMockInterface(t)
+	fileSystem.EXPECT().FindFiles([]string{"path"}, thePathFilter).Return([]fs.File{
+		{Path: "path/file1"},
+		{Path: "path/file2"},
+	}, nil)
+	gitHub := github_mock.NewMockInterface(t)
+	gitHub.EXPECT().
+		QueryForCommit(ctx, github.QueryForCommitInput{
+			ParentRepository: parentRepositoryID,
+			TargetRepository: targetRepositoryID,
+			TargetBranchName: "topic",
+		}).
+		Return(&github.QueryForCommitOutput{
+			CurrentUserName:       "current",
+			TargetBranchNodeID:    targetBranchNodeID,
+			TargetBranchCommitSHA: "topicCommitSHA",
+			TargetBranchTreeSHA:   "topicTreeSHA",
+		}, nil)
+	gitHub.EXPECT().
+		GetTree(ctx, targetRepositoryID, git.TreeSHA("topicTreeSHA")).
+		Return(&git.Tree{
+			SHA: "topicTreeSHA",
+			Files: []git.File{
+				{Filename: "README.md"},
+				{Filename: "path/file1"},
+				{Filename: "path/file3"},
+				{Filename: "path/dir/file4"},
+				{Filename: "pathname"},
+			},
+		}, nil)
+	gitHub.EXPECT().
+		UpdateBranch(ctx, github.UpdateBranchInput{
+			BranchRefNodeID: targetBranchNodeID,
+			CommitSHA:       "commitSHA",
+		}).
+		Return(nil)
+	createGitObject := gitobject_mock.NewMockInterface(t)
+	createGitObject.EXPECT().
+		Do(ctx, gitobject.Input{
+			Files: []gitobject.File{
+				{Path: "path/file1", Filename: "path/file1"},
+				{Path: "path/file2", Filename: "path/file2"},
+			},
+			DeletedFiles:     []string{"path/file3", "path/dir/file4"},
+			Repository:       targetRepositoryID,
+			CommitMessage:    "message",
+			ParentCommitSHA:  "topicCommitSHA",
+			ParentTreeSHA:    "topicTreeSHA",
+			ParentRepository: targetRepositoryID,
+		}).
+		Return(&gitobject.Output{
+			CommitSHA:    "commitSHA",
+			ChangedFiles: 3,
+			DeletedFiles: 2,
+		}, nil)
+
+	useCase := Commit{
+		CreateGitObject: createGitObject,
+		FileSystem:      fileSystem,
+		GitHub:          gitHub,
+	}
+	if _, err := useCase.Do(ctx, in); err != nil {
+		t.Errorf("err wants nil but %+v", err)
+	}
+}
+
+func TestCommitToBranch_Do_SyncToNewBranch(t *testing.T) {
+	ctx := context.TODO()
+	in := Input{
+		TargetRepository: targetRepositoryID,
+		TargetBranchName: "topic",
+		ParentRepository: parentRepositoryID,
+		CommitStrategy:   commitstrategy.FastForward,
+		CommitMessage:    "message",
+		Paths:            []string{"path"},
+		Sync:             true,
+	}
+	fileSystem := fs_mock.NewMockInterface(t)
+	fileSystem.EXPECT().FindFiles([]string{"path"}, thePathFilter).Return([]fs.File{
+		{Path: "path/file1"},
+	}, nil)
+	gitHub := github_mock.NewMockInterface(t)
+	gitHub.EXPECT().
+		QueryForCommit(ctx, github.QueryForCommitInput{
+			ParentRepository: parentRepositoryID,
+			TargetRepository: targetRepositoryID,
+			TargetBranchName: "topic",
+		}).
+		Return(&github.QueryForCommitOutput{
+			CurrentUserName:              "current",
+			ParentDefaultBranchCommitSHA: "masterCommitSHA",
+			ParentDefaultBranchTreeSHA:   "masterTreeSHA",
+			TargetRepositoryNodeID:       targetRepositoryNodeID,
+		}, nil)
+	// the parent tree is read from the parent repository
+	gitHub.EXPECT().
+		GetTree(ctx, parentRepositoryID, git.TreeSHA("masterTreeSHA")).
+		Return(&git.Tree{
+			SHA: "masterTreeSHA",
+			Files: []git.File{
+				{Filename: "path/file1"},
+				{Filename: "path/file2"},
+			},
+		}, nil)
+	gitHub.EXPECT().
+		CreateBranch(ctx, github.CreateBranchInput{
+			RepositoryNodeID: targetRepositoryNodeID,
+			BranchName:       "topic",
+			CommitSHA:        "commitSHA",
+		}).
+		Return(nil)
+	createGitObject := gitobject_mock.NewMockInterface(t)
+	createGitObject.EXPECT().
+		Do(ctx, gitobject.Input{
+			Files: []gitobject.File{
+				{Path: "path/file1", Filename: "path/file1"},
+			},
+			DeletedFiles:     []string{"path/file2"},
+			Repository:       targetRepositoryID,
+			CommitMessage:    "message",
+			ParentCommitSHA:  "masterCommitSHA",
+			ParentTreeSHA:    "masterTreeSHA",
+			ParentRepository: parentRepositoryID,
+		}).
+		Return(&gitobject.Output{
+			CommitSHA:    "commitSHA",
+			ChangedFiles: 1,
+			DeletedFiles: 1,
+		}, nil)
+
+	useCase := Commit{
+		CreateGitObject: createGitObject,
+		FileSystem:      fileSystem,
+		GitHub:          gitHub,
+	}
+	if _, err := useCase.Do(ctx, in); err != nil {
+		t.Errorf("err wants nil but %+v", err)
+	}
+}
+
+func TestCommitToBranch_Do_SyncWithExclude(t *testing.T) {
+	ctx := context.TODO()
+	in := Input{
+		TargetRepository: targetRepositoryID,
+		TargetBranchName: "topic",
+		ParentRepository: parentRepositoryID,
+		CommitStrategy:   commitstrategy.FastForward,
+		CommitMessage:    "message",
+		Paths:            []string{"path"},
+		Sync:             true,
+		Exclude:          []string{"path/dir", "**/*.log"},
+	}
+	fileSystem := fs_mock.NewMockInterface(t)
+	fileSystem.EXPECT().FindFiles([]string{"path"}, thePathFilter).Return([]fs.File{
+		{Path: "path/file1"},
+		{Path: "path/file2"},
+	}, nil)
+	gitHub := github_mock.NewMockInterface(t)
+	gitHub.EXPECT().
+		QueryForCommit(ctx, github.QueryForCommitInput{
+			ParentRepository: parentRepositoryID,
+			TargetRepository: targetRepositoryID,
+			TargetBranchName: "topic",
+		}).
+		Return(&github.QueryForCommitOutput{
+			CurrentUserName:       "current",
+			TargetBranchNodeID:    targetBranchNodeID,
+			TargetBranchCommitSHA: "topicCommitSHA",
+			TargetBranchTreeSHA:   "topicTreeSHA",
+		}, nil)
+	gitHub.EXPECT().
+		GetTree(ctx, targetRepositoryID, git.TreeSHA("topicTreeSHA")).
+		Return(&git.Tree{
+			SHA: "topicTreeSHA",
+			Files: []git.File{
+				{Filename: "README.md"},
+				{Filename: "path/file1"},
+				{Filename: "path/file3"},
+				{Filename: "path/dir/file4"},
+				{Filename: "path/file5.log"},
+				{Filename: "pathname"},
+			},
+		}, nil)
+	gitHub.EXPECT().
+		UpdateBranch(ctx, github.UpdateBranchInput{
+			BranchRefNodeID: targetBranchNodeID,
+			CommitSHA:       "commitSHA",
+		}).
+		Return(nil)
+	createGitObject := gitobject_mock.NewMockInterface(t)
+	createGitObject.EXPECT().
+		Do(ctx, gitobject.Input{
+			Files: []gitobject.File{
+				{Path: "path/file1", Filename: "path/file1"},
+				{Path: "path/file2", Filename: "path/file2"},
+			},
+			DeletedFiles:     []string{"path/file3"},
+			Repository:       targetRepositoryID,
+			CommitMessage:    "message",
+			ParentCommitSHA:  "topicCommitSHA",
+			ParentTreeSHA:    "topicTreeSHA",
+			ParentRepository: targetRepositoryID,
+		}).
+		Return(&gitobject.Output{
+			CommitSHA:    "commitSHA",
+			ChangedFiles: 2,
+			DeletedFiles: 1,
+		}, nil)
+
+	useCase := Commit{
+		CreateGitObject: createGitObject,
+		FileSystem:      fileSystem,
+		GitHub:          gitHub,
+	}
+	if _, err := useCase.Do(ctx, in); err != nil {
+		t.Errorf("err wants nil but %+v", err)
+	}
+}
+
+func TestCommitToBranch_Plan(t *testing.T) {
+	ctx := context.TODO()
+	in := Input{
+		TargetRepository: targetRepositoryID,
+		TargetBranchName: "topic",
+		ParentRepository: parentRepositoryID,
+		CommitStrategy:   commitstrategy.FastForward,
+		CommitMessage:    "message",
+		Paths:            []string{"path"},
+		DeletePaths:      []string{"file3"},
+	}
+	changes := gitobject.Changes{
+		Added:   []string{"file1"},
+		Deleted: []string{"file3"},
+	}
+
+	t.Run("when the branch exists, it should compare with the branch", func(t *testing.T) {
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			QueryForCommit(ctx, github.QueryForCommitInput{
+				ParentRepository: parentRepositoryID,
+				TargetRepository: targetRepositoryID,
+				TargetBranchName: "topic",
+			}).
+			Return(&github.QueryForCommitOutput{
+				CurrentUserName:              "current",
+				ParentDefaultBranchCommitSHA: "masterCommitSHA",
+				ParentDefaultBranchTreeSHA:   "masterTreeSHA",
+				TargetBranchNodeID:           targetBranchNodeID,
+				TargetBranchCommitSHA:        "topicCommitSHA",
+				TargetBranchTreeSHA:          "topicTreeSHA",
+			}, nil)
+		createGitObject := gitobject_mock.NewMockInterface(t)
+		createGitObject.EXPECT().
+			Plan(ctx, gitobject.Input{
+				Files:            theGitObjectFiles,
+				DeletedFiles:     []string{"file3"},
+				Repository:       targetRepositoryID,
+				ParentCommitSHA:  "topicCommitSHA",
+				ParentTreeSHA:    "topicTreeSHA",
+				ParentRepository: targetRepositoryID,
+			}).
+			Return(&changes, nil)
+
+		useCase := Commit{
+			CreateGitObject: createGitObject,
+			FileSystem:      newFileSystemMock(t),
+			GitHub:          gitHub,
+		}
+		got, err := useCase.Plan(ctx, in)
+		if err != nil {
+			t.Fatalf("err wants nil but %+v", err)
+		}
+		want := &PlanOutput{
+			Repository:      targetRepositoryID,
+			BranchName:      "topic",
+			BranchExists:    true,
+			ParentCommitSHA: "topicCommitSHA",
+			Changes:         changes,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("when the branch does not exist, it should compare with the default branch", func(t *testing.T) {
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			QueryForCommit(ctx, github.QueryForCommitInput{
+				ParentRepository: parentRepositoryID,
+				TargetRepository: targetRepositoryID,
+				TargetBranchName: "topic",
+			}).
+			Return(&github.QueryForCommitOutput{
+				CurrentUserName:              "current",
+				ParentDefaultBranchCommitSHA: "masterCommitSHA",
+				ParentDefaultBranchTreeSHA:   "masterTreeSHA",
+				TargetRepositoryNodeID:       targetRepositoryNodeID,
+			}, nil)
+		createGitObject := gitobject_mock.NewMockInterface(t)
+		createGitObject.EXPECT().
+			Plan(ctx, gitobject.Input{
+				Files:            theGitObjectFiles,
+				DeletedFiles:     []string{"file3"},
+				Repository:       targetRepositoryID,
+				ParentCommitSHA:  "masterCommitSHA",
+				ParentTreeSHA:    "masterTreeSHA",
+				ParentRepository: parentRepositoryID,
+			}).
+			Return(&changes, nil)
+
+		useCase := Commit{
+			CreateGitObject: createGitObject,
+			FileSystem:      newFileSystemMock(t),
+			GitHub:          gitHub,
+		}
+		got, err := useCase.Plan(ctx, in)
+		if err != nil {
+			t.Fatalf("err wants nil but %+v", err)
+		}
+		want := &PlanOutput{
+			Repository:      targetRepositoryID,
+			BranchName:      "topic",
+			ParentCommitSHA: "masterCommitSHA",
+			Changes:         changes,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("when the history is rewritten, it should force-update the branch", func(t *testing.T) {
+		in := in
+		in.CommitStrategy = commitstrategy.FastForwardKeepLast(2)
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			QueryForCommit(ctx, github.QueryForCommitInput{
+				ParentRepository: parentRepositoryID,
+				TargetRepository: targetRepositoryID,
+				TargetBranchName: "topic",
+			}).
+			Return(&github.QueryForCommitOutput{
+				CurrentUserName:       "current",
+				TargetBranchNodeID:    targetBranchNodeID,
+				TargetBranchCommitSHA: "topicCommitSHA",
+				TargetBranchTreeSHA:   "topicTreeSHA",
+			}, nil)
+		gitHub.EXPECT().
+			GetCommit(ctx, targetRepositoryID, git.CommitSHA("topicCommitSHA")).
+			Return(&git.Commit{SHA: "topicCommitSHA", Parents: []git.CommitSHA{"oldCommitSHA"}, TreeSHA: "topicTreeSHA"}, nil)
+		createGitObject := gitobject_mock.NewMockInterface(t)
+		createGitObject.EXPECT().
+			Plan(ctx, gitobject.Input{
+				Files:            theGitObjectFiles,
+				DeletedFiles:     []string{"file3"},
+				Repository:       targetRepositoryID,
+				ParentCommitSHA:  "topicCommitSHA",
+				ParentTreeSHA:    "topicTreeSHA",
+				ParentRepository: targetRepositoryID,
+			}).
+			Return(&changes, nil)
+
+		useCase := Commit{
+			CreateGitObject: createGitObject,
+			FileSystem:      newFileSystemMock(t),
+			GitHub:          gitHub,
+		}
+		got, err := useCase.Plan(ctx, in)
+		if err != nil {
+			t.Fatalf("err wants nil but %+v", err)
+		}
+		want := &PlanOutput{
+			Repository:      targetRepositoryID,
+			BranchName:      "topic",
+			BranchExists:    true,
+			ParentCommitSHA: "topicCommitSHA",
+			RewriteHistory:  true,
+			ForceUpdate:     true,
+			Changes:         changes,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
+
 func Test_pathFilter_SkipDir(t *testing.T) {
 	for _, c := range []struct {
 		path string
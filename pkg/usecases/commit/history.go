@@ -0,0 +1,70 @@
+package commit
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/int128/ghcp/pkg/git"
+	"github.com/int128/ghcp/pkg/usecases/gitobject"
+)
+
+// keepLastCommits rewrites the history of the parent commit so that the branch has only the last n commits
+// including the new commit, where n is given by the commit strategy.
+// The commits to keep are recreated on a new root with the same trees, messages, authors and committers.
+// A merge commit is recreated with only the first parent.
+// It sets the parent of the new commit to the new head and returns true if the history is rewritten.
+func (u *Commit) keepLastCommits(ctx context.Context, in Input, gitObj *gitobject.Input) (bool, error) {
+	commits, err := u.findCommitsToKeep(ctx, in, gitObj.ParentCommitSHA)
+	if err != nil {
+		return false, err
+	}
+	if commits == nil {
+		return false, nil
+	}
+
+	slog.Info("Rewriting the history to keep the last commits", "branch", in.TargetBranchName, "commits", len(commits)+1)
+	var newHead git.CommitSHA
+	for _, commit := range slices.Backward(commits) {
+		sha, err := u.GitHub.CreateCommit(ctx, git.NewCommit{
+			Repository:      in.TargetRepository,
+			Message:         commit.Message,
+			Author:          &commit.Author,
+			Committer:       &commit.Committer,
+			ParentCommitSHA: newHead,
+			TreeSHA:         commit.TreeSHA,
+		})
+		if err != nil {
+			return false, fmt.Errorf("could not recreate the commit %s: %w", commit.SHA, err)
+		}
+		slog.Debug("Recreated the commit", "commit", commit.SHA, "newCommit", sha)
+		newHead = sha
+	}
+	gitObj.ParentCommitSHA = newHead // no parent if only the new commit is kept
+	return true, nil
+}
+
+// findCommitsToKeep returns the commits to recreate from the parent commit, in order from the head to the oldest.
+// It returns nil if the history is not longer than the limit, i.e., the history is not rewritten.
+// It calls only the read API, so that Plan can determine whether the history is rewritten.
+func (u *Commit) findCommitsToKeep(ctx context.Context, in Input, parentCommitSHA git.CommitSHA) ([]*git.Commit, error) {
+	keepParents := in.CommitStrategy.KeepLast() - 1
+	if keepParents < 0 || parentCommitSHA == "" {
+		return nil, nil
+	}
+	commits := []*git.Commit{}
+	for sha := parentCommitSHA; len(commits) < keepParents; {
+		commit, err := u.GitHub.GetCommit(ctx, in.TargetRepository, sha)
+		if err != nil {
+			return nil, fmt.Errorf("could not get the commit %s: %w", sha, err)
+		}
+		commits = append(commits, commit)
+		if len(commit.Parents) == 0 {
+			slog.Debug("Keeping the history because it is not longer than the limit", "commits", len(commits)+1)
+			return nil, nil
+		}
+		sha = commit.Parents[0]
+	}
+	return commits, nil
+}
@@ -0,0 +1,32 @@
+package commit
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/int128/ghcp/pkg/usecases/gitobject"
+)
+
+// gitAttributesFilename is the file to determine the files for Git LFS.
+// It must be in the current directory.
+const gitAttributesFilename = ".gitattributes"
+
+// markLFSFiles sets the LFS flag to the files matched to filter=lfs in .gitattributes.
+// The patterns are matched to the paths in the repository.
+func (u *Commit) markLFSFiles(files []gitobject.File) error {
+	rules, err := u.FileSystem.ReadLFSRules(gitAttributesFilename)
+	if err != nil {
+		return fmt.Errorf("could not read %s: %w", gitAttributesFilename, err)
+	}
+	if len(rules) == 0 {
+		slog.Warn("No file is stored in Git LFS because no pattern of filter=lfs is found", "file", gitAttributesFilename)
+		return nil
+	}
+	for i := range files {
+		files[i].LFS = rules.Match(files[i].Filename)
+		if files[i].LFS {
+			slog.Debug("Storing the file in Git LFS", "file", files[i].Path, "filename", files[i].Filename)
+		}
+	}
+	return nil
+}
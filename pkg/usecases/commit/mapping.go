@@ -0,0 +1,119 @@
+package commit
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/int128/ghcp/pkg/fs"
+	"github.com/int128/ghcp/pkg/usecases/gitobject"
+)
+
+// PathMapping represents how local paths are mapped to paths in the repository.
+// A local path is mapped as follows:
+//
+//  1. If a rule matches, replace the source with the destination of the longest match.
+//     Otherwise, strip StripPrefix if the path has it.
+//  2. Prepend DestDir.
+//
+// If the zero value is given, a local path is used as-is.
+type PathMapping struct {
+	StripPrefix string            // optional
+	DestDir     string            // optional
+	Rules       []PathMappingRule // optional
+}
+
+// PathMappingRule represents a pair of local path and path in the repository.
+type PathMappingRule struct {
+	Source      string
+	Destination string
+}
+
+// ParsePathMappingRule parses the string in form of SRC:DEST.
+func ParsePathMappingRule(s string) (PathMappingRule, error) {
+	src, dest, ok := strings.Cut(s, ":")
+	if !ok || src == "" {
+		return PathMappingRule{}, fmt.Errorf("path mapping must be in form of SRC:DEST but was %s", s)
+	}
+	return PathMappingRule{Source: src, Destination: dest}, nil
+}
+
+// resolve returns the path in the repository for the local path.
+// The returned path is cleaned and slash-separated, but may point outside the repository.
+func (m PathMapping) resolve(localPath string) string {
+	p := cleanPath(localPath)
+	matched := false
+	var src, dest string
+	for _, rule := range m.Rules {
+		ruleSrc := cleanPath(rule.Source)
+		if hasPathPrefix(p, ruleSrc) && (!matched || len(ruleSrc) > len(src)) {
+			matched, src, dest = true, ruleSrc, cleanPath(rule.Destination)
+		}
+	}
+	switch {
+	case matched:
+		p = path.Join(dest, trimPathPrefix(p, src))
+	case m.StripPrefix != "" && hasPathPrefix(p, cleanPath(m.StripPrefix)):
+		p = trimPathPrefix(p, cleanPath(m.StripPrefix))
+	}
+	if m.DestDir == "" {
+		return p
+	}
+	return path.Join(cleanPath(m.DestDir), p)
+}
+
+// resolveFiles returns the files with the paths in the repository.
+func (m PathMapping) resolveFiles(files []fs.File) ([]gitobject.File, error) {
+	var resolved []gitobject.File
+	for _, file := range files {
+		filename := m.resolve(file.Path)
+		if err := validateFilename(filename); err != nil {
+			return nil, fmt.Errorf("invalid path mapping for %s: %w", file.Path, err)
+		}
+		resolved = append(resolved, gitobject.File{
+			Path:       file.Path,
+			Filename:   filename,
+			Executable: file.Executable,
+			Size:       file.Size,
+		})
+	}
+	return resolved, nil
+}
+
+// validateFilename returns an error if the filename points outside the repository.
+func validateFilename(filename string) error {
+	if filename == "" || filename == "." {
+		return fmt.Errorf("path must not be the root of the repository")
+	}
+	if path.IsAbs(filename) || filename == ".." || strings.HasPrefix(filename, "../") {
+		return fmt.Errorf("path %s is outside the repository", filename)
+	}
+	for _, elem := range strings.Split(filename, "/") {
+		if elem == ".git" {
+			return fmt.Errorf("path %s must not contain .git", filename)
+		}
+	}
+	return nil
+}
+
+func cleanPath(p string) string {
+	if p == "" {
+		return "."
+	}
+	return path.Clean(filepath.ToSlash(p))
+}
+
+func hasPathPrefix(p, prefix string) bool {
+	return prefix == "." || p == prefix || strings.HasPrefix(p, prefix+"/")
+}
+
+func trimPathPrefix(p, prefix string) string {
+	if prefix == "." {
+		return p
+	}
+	if p == prefix {
+		return "."
+	}
+	return strings.TrimPrefix(p, prefix+"/")
+}
@@ -0,0 +1,122 @@
+package commit
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/int128/ghcp/pkg/fs"
+	"github.com/int128/ghcp/pkg/usecases/gitobject"
+)
+
+func TestPathMapping_resolve(t *testing.T) {
+	for _, c := range []struct {
+		name      string
+		mapping   PathMapping
+		localPath string
+		want      string
+	}{
+		{name: "ZeroValue", localPath: "dir/file", want: "dir/file"},
+		{name: "DestDir", mapping: PathMapping{DestDir: "docs"}, localPath: "index.html", want: "docs/index.html"},
+		{name: "StripPrefix", mapping: PathMapping{StripPrefix: "build/out"}, localPath: "build/out/index.html", want: "index.html"},
+		{name: "StripPrefixNotMatched", mapping: PathMapping{StripPrefix: "build/out"}, localPath: "build/outside.html", want: "build/outside.html"},
+		{name: "StripPrefixAndDestDir", mapping: PathMapping{StripPrefix: "build/out/", DestDir: "docs/"}, localPath: "build/out/index.html", want: "docs/index.html"},
+		{
+			name:      "Rule",
+			mapping:   PathMapping{Rules: []PathMappingRule{{Source: "build/out", Destination: "docs"}}},
+			localPath: "build/out/css/main.css",
+			want:      "docs/css/main.css",
+		},
+		{
+			name:      "RuleForFile",
+			mapping:   PathMapping{Rules: []PathMappingRule{{Source: "dist/app.js", Destination: "static/app.min.js"}}},
+			localPath: "dist/app.js",
+			want:      "static/app.min.js",
+		},
+		{
+			name: "LongestRule",
+			mapping: PathMapping{Rules: []PathMappingRule{
+				{Source: "build", Destination: "a"},
+				{Source: "build/out", Destination: "b"},
+			}},
+			localPath: "build/out/index.html",
+			want:      "b/index.html",
+		},
+		{
+			name:      "RuleTakesPrecedenceOverStripPrefix",
+			mapping:   PathMapping{StripPrefix: "build", Rules: []PathMappingRule{{Source: "build/out", Destination: "docs"}}},
+			localPath: "build/out/index.html",
+			want:      "docs/index.html",
+		},
+		{
+			name:      "RuleAndDestDir",
+			mapping:   PathMapping{DestDir: "site", Rules: []PathMappingRule{{Source: "build/out", Destination: "docs"}}},
+			localPath: "build/out/index.html",
+			want:      "site/docs/index.html",
+		},
+		{name: "ParentDirectory", localPath: "../file", want: "../file"},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			got := c.mapping.resolve(c.localPath)
+			if got != c.want {
+				t.Errorf("resolve wants %s but %s", c.want, got)
+			}
+		})
+	}
+}
+
+func TestPathMapping_resolveFiles(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		m := PathMapping{StripPrefix: "build", DestDir: "docs"}
+		got, err := m.resolveFiles([]fs.File{
+			{Path: "build/index.html"},
+			{Path: "build/run.sh", Executable: true},
+		})
+		if err != nil {
+			t.Fatalf("resolveFiles returned error: %+v", err)
+		}
+		want := []gitobject.File{
+			{Path: "build/index.html", Filename: "docs/index.html"},
+			{Path: "build/run.sh", Filename: "docs/run.sh", Executable: true},
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	for name, c := range map[string]struct {
+		mapping PathMapping
+		path    string
+	}{
+		"OutsideRepository": {mapping: PathMapping{DestDir: ".."}, path: "file"},
+		"ParentDirectory":   {path: "../file"},
+		"AbsolutePath":      {path: "/tmp/file"},
+		"Root":              {mapping: PathMapping{Rules: []PathMappingRule{{Source: "file", Destination: ""}}}, path: "file"},
+		"GitDirectory":      {mapping: PathMapping{DestDir: ".git/hooks"}, path: "file"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := c.mapping.resolveFiles([]fs.File{{Path: c.path}})
+			if err == nil {
+				t.Errorf("err wants non-nil but nil")
+			}
+		})
+	}
+}
+
+func TestParsePathMappingRule(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		got, err := ParsePathMappingRule("build/out:docs")
+		if err != nil {
+			t.Fatalf("ParsePathMappingRule returned error: %+v", err)
+		}
+		want := PathMappingRule{Source: "build/out", Destination: "docs"}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("NoSeparator", func(t *testing.T) {
+		if _, err := ParsePathMappingRule("build/out"); err == nil {
+			t.Errorf("err wants non-nil but nil")
+		}
+	})
+}
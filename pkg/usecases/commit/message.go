@@ -0,0 +1,63 @@
+package commit
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/int128/ghcp/pkg/git"
+	"github.com/int128/ghcp/pkg/usecases/gitobject"
+)
+
+// messageTemplateEnvPrefix is the prefix of environment variables
+// which the commit message template can read.
+// Other variables such as GITHUB_TOKEN are not exposed to the template.
+const messageTemplateEnvPrefix = "GHCP_VAR_"
+
+// messageTemplateData represents the variables of the commit message template.
+type messageTemplateData struct {
+	Repository   string        // OWNER/REPO
+	Branch       string        // name of the branch to commit
+	ParentSHA    git.CommitSHA // empty if no parent
+	Files        []string      // paths in the repository of the files to commit
+	DeletedFiles []string      // paths in the repository to delete
+}
+
+// renderCommitMessage renders the commit message as a Go template.
+// The function env returns the value of the environment variable with messageTemplateEnvPrefix.
+func (u *Commit) renderCommitMessage(in Input, gitObj gitobject.Input) (git.CommitMessage, error) {
+	tpl, err := template.New("message").
+		Funcs(template.FuncMap{
+			"env":  u.getenvForTemplate,
+			"join": strings.Join,
+		}).
+		Parse(string(in.CommitMessage))
+	if err != nil {
+		return "", fmt.Errorf("invalid commit message template: %w", err)
+	}
+	data := messageTemplateData{
+		Repository:   in.TargetRepository.String(),
+		Branch:       string(in.TargetBranchName),
+		ParentSHA:    gitObj.ParentCommitSHA,
+		DeletedFiles: gitObj.DeletedFiles,
+	}
+	for _, file := range gitObj.Files {
+		data.Files = append(data.Files, file.Filename)
+	}
+	var b strings.Builder
+	if err := tpl.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("could not render the commit message template: %w", err)
+	}
+	message := strings.TrimSpace(b.String())
+	if message == "" {
+		return "", fmt.Errorf("commit message is empty after rendering the template")
+	}
+	return git.CommitMessage(message), nil
+}
+
+func (u *Commit) getenvForTemplate(key string) (string, error) {
+	if !strings.HasPrefix(key, messageTemplateEnvPrefix) {
+		return "", fmt.Errorf("environment variable %s is not allowed (must start with %s)", key, messageTemplateEnvPrefix)
+	}
+	return u.Env.Getenv(key), nil
+}
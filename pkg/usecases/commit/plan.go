@@ -0,0 +1,89 @@
+package commit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/int128/ghcp/pkg/git"
+	"github.com/int128/ghcp/pkg/usecases/gitobject"
+)
+
+// PlanOutput represents the changes which Do would make to the branch.
+type PlanOutput struct {
+	Repository      git.RepositoryID
+	BranchName      git.BranchName
+	BranchExists    bool
+	ParentCommitSHA git.CommitSHA // empty if the commit has no parent
+	RewriteHistory  bool          // true if the history is rewritten to keep the last commits
+	ForceUpdate     bool          // true if the existing branch is updated even if it is not fast-forward
+	Changes         gitobject.Changes
+}
+
+// Plan returns the changes which Do would make to the branch.
+// It compares the local files with the parent tree and does not call any write API.
+func (u *Commit) Plan(ctx context.Context, in Input) (*PlanOutput, error) {
+	if !in.TargetRepository.IsValid() {
+		return nil, errors.New("you must set GitHub repository")
+	}
+	if in.Sync && len(in.Paths) == 0 {
+		return nil, errors.New("you must set one or more paths to sync")
+	}
+	files, filter, err := u.findFiles(&in)
+	if err != nil {
+		return nil, err
+	}
+	if in.TargetBranchName == "" {
+		in.TargetBranchName, err = u.queryDefaultBranchName(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+	}
+	q, err := u.queryForCommit(ctx, in)
+	if err != nil {
+		return nil, fmt.Errorf("could not find the repository: %w", err)
+	}
+	if in.Lease != "" {
+		if err := checkLease(in, q); err != nil {
+			return nil, err
+		}
+	}
+
+	gitObj := gitobject.Input{
+		Files:        files,
+		DeletedFiles: in.DeletePaths,
+		Repository:   in.TargetRepository,
+		NoFileMode:   in.NoFileMode,
+	}
+	if err := u.setParent(ctx, in, q, &gitObj); err != nil {
+		return nil, err
+	}
+	// the recreated commits have the same trees, so the changes are compared with the current parent
+	commitsToKeep, err := u.findCommitsToKeep(ctx, in, gitObj.ParentCommitSHA)
+	if err != nil {
+		return nil, err
+	}
+	slog.Debug("Comparing the files with the parent", "branch", in.TargetBranchName, "parent", gitObj.ParentCommitSHA)
+	if in.Sync {
+		deletedFiles, err := u.findFilesToSyncDelete(ctx, in, files, filter, gitObj.ParentRepository, gitObj.ParentTreeSHA)
+		if err != nil {
+			return nil, fmt.Errorf("error while finding files to delete: %w", err)
+		}
+		gitObj.DeletedFiles = append(slices.Clip(gitObj.DeletedFiles), deletedFiles...)
+	}
+	changes, err := u.CreateGitObject.Plan(ctx, gitObj)
+	if err != nil {
+		return nil, fmt.Errorf("error while comparing the files: %w", err)
+	}
+	return &PlanOutput{
+		Repository:      in.TargetRepository,
+		BranchName:      in.TargetBranchName,
+		BranchExists:    q.TargetBranchExists(),
+		ParentCommitSHA: gitObj.ParentCommitSHA,
+		RewriteHistory:  commitsToKeep != nil,
+		ForceUpdate:     q.TargetBranchExists() && (in.ForceUpdate || commitsToKeep != nil),
+		Changes:         *changes,
+	}, nil
+}
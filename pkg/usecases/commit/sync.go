@@ -0,0 +1,82 @@
+package commit
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"strings"
+
+	"github.com/int128/ghcp/pkg/fs"
+	"github.com/int128/ghcp/pkg/git"
+	"github.com/int128/ghcp/pkg/usecases/gitobject"
+)
+
+// findFilesToSyncDelete returns the files which exist under the paths in the parent tree
+// but do not exist in the local files, i.e. rsync --delete semantics.
+// As well as rsync, it keeps the files excluded by the filter.
+// The parent tree is read from the repository which the parent commit was resolved from.
+func (u *Commit) findFilesToSyncDelete(ctx context.Context, in Input, files []gitobject.File, filter fs.FindFilesFilter, parentRepository git.RepositoryID, parentTreeSHA git.TreeSHA) ([]string, error) {
+	if parentTreeSHA == "" {
+		slog.Debug("Nothing to delete because there is no parent tree")
+		return nil, nil
+	}
+	tree, err := u.GitHub.GetTree(ctx, parentRepository, parentTreeSHA)
+	if err != nil {
+		return nil, fmt.Errorf("could not get the tree %s: %w", parentTreeSHA, err)
+	}
+	localFiles := make(map[string]bool, len(files))
+	for _, file := range files {
+		localFiles[file.Filename] = true
+	}
+	var deletedFiles []string
+	for _, remoteFile := range tree.Files {
+		if localFiles[remoteFile.Filename] {
+			continue
+		}
+		root, rel, ok := in.PathMapping.findLocalPath(remoteFile.Filename, in.Paths)
+		if !ok {
+			continue
+		}
+		if isExcludedLocally(filter, root, rel) {
+			slog.Debug("Keep the file excluded locally", "file", remoteFile.Filename)
+			continue
+		}
+		slog.Debug("File does not exist locally", "file", remoteFile.Filename)
+		deletedFiles = append(deletedFiles, remoteFile.Filename)
+	}
+	slog.Info("Deleting files which do not exist locally", "files", len(deletedFiles))
+	return deletedFiles, nil
+}
+
+// findLocalPath returns the local path which is mapped to the filename in the repository.
+// It returns the local path in the paths and the relative path from it,
+// or false if the filename is not under any of the paths.
+func (m PathMapping) findLocalPath(filename string, paths []string) (string, string, bool) {
+	for _, p := range paths {
+		if dest := m.resolve(p); hasPathPrefix(filename, dest) {
+			return p, trimPathPrefix(filename, dest), true
+		}
+	}
+	return "", "", false
+}
+
+// isExcludedLocally returns true if the filter excludes the local path, i.e. root/rel.
+// As well as fs.FindFiles, the directories from the root are checked in order.
+func isExcludedLocally(filter fs.FindFilesFilter, root, rel string) bool {
+	if rel == "." {
+		return filter.ExcludeFile(root)
+	}
+	if filter.SkipDir(root) {
+		return true
+	}
+	dir := root
+	elems := strings.Split(rel, "/")
+	for _, elem := range elems[:len(elems)-1] {
+		dir = filepath.Join(dir, elem)
+		if filter.SkipDir(dir) {
+			return true
+		}
+	}
+	return filter.ExcludeFile(filepath.Join(dir, elems[len(elems)-1]))
+}
@@ -19,7 +19,7 @@ var Set = wire.NewSet(
 )
 
 type Interface interface {
-	Do(ctx context.Context, in Input) error
+	Do(ctx context.Context, in Input) (*commit.Output, error)
 }
 
 type Input struct {
@@ -27,10 +27,17 @@ type Input struct {
 	TargetBranchName git.BranchName
 	CommitStrategy   commitstrategy.CommitStrategy
 	CommitMessage    git.CommitMessage
+	MessageTemplate  bool              // render CommitMessage as a Go template
 	Author           *git.CommitAuthor // optional
 	Committer        *git.CommitAuthor // optional
+	SigningKey       *git.SigningKey   // sign the commit if set (optional)
 	Paths            []string
 	NoFileMode       bool
+	Parallelism      int      // number of files to upload concurrently (default: 1)
+	LFS              bool     // upload the files matched to filter=lfs in .gitattributes to Git LFS
+	GitIgnore        bool     // exclude the files ignored by .gitignore
+	Include          []string // glob patterns of the local files to include (optional)
+	Exclude          []string // glob patterns of the local files to exclude (optional)
 	DryRun           bool
 }
 
@@ -39,37 +46,45 @@ type ForkCommit struct {
 	GitHub github.Interface
 }
 
-func (u *ForkCommit) Do(ctx context.Context, in Input) error {
+func (u *ForkCommit) Do(ctx context.Context, in Input) (*commit.Output, error) {
 	if !in.ParentRepository.IsValid() {
-		return errors.New("you must set GitHub repository")
+		return nil, errors.New("you must set GitHub repository")
 	}
 	if in.TargetBranchName == "" {
-		return errors.New("you must set target branch name")
+		return nil, errors.New("you must set target branch name")
 	}
 	if in.CommitMessage == "" {
-		return errors.New("you must set commit message")
+		return nil, errors.New("you must set commit message")
 	}
 	if len(in.Paths) == 0 {
-		return errors.New("you must set one or more paths")
+		return nil, errors.New("you must set one or more paths")
 	}
 
 	fork, err := u.GitHub.CreateFork(ctx, in.ParentRepository)
 	if err != nil {
-		return fmt.Errorf("could not fork the repository: %w", err)
+		return nil, fmt.Errorf("could not fork the repository: %w", err)
 	}
-	if err := u.Commit.Do(ctx, commit.Input{
+	out, err := u.Commit.Do(ctx, commit.Input{
 		TargetRepository: *fork,
 		TargetBranchName: in.TargetBranchName,
 		ParentRepository: in.ParentRepository,
 		CommitStrategy:   in.CommitStrategy,
 		CommitMessage:    in.CommitMessage,
+		MessageTemplate:  in.MessageTemplate,
 		Author:           in.Author,
 		Committer:        in.Committer,
+		SigningKey:       in.SigningKey,
 		Paths:            in.Paths,
 		NoFileMode:       in.NoFileMode,
+		Parallelism:      in.Parallelism,
+		LFS:              in.LFS,
+		GitIgnore:        in.GitIgnore,
+		Include:          in.Include,
+		Exclude:          in.Exclude,
 		DryRun:           in.DryRun,
-	}); err != nil {
-		return fmt.Errorf("could not fork and commit: %w", err)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fork and commit: %w", err)
 	}
-	return nil
+	return out, nil
 }
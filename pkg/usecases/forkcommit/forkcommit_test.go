@@ -40,13 +40,13 @@ func TestForkCommit_Do(t *testing.T) {
 				CommitMessage:    "message",
 				Paths:            []string{"path"},
 			}).
-			Return(nil)
+			Return(&commit.Output{}, nil)
 
 		u := ForkCommit{
 			Commit: commitUseCase,
 			GitHub: gitHub,
 		}
-		if err := u.Do(ctx, in); err != nil {
+		if _, err := u.Do(ctx, in); err != nil {
 			t.Errorf("err wants nil but %+v", err)
 		}
 	})
@@ -75,13 +75,13 @@ func TestForkCommit_Do(t *testing.T) {
 				CommitMessage:    "message",
 				Paths:            []string{"path"},
 			}).
-			Return(nil)
+			Return(&commit.Output{}, nil)
 
 		u := ForkCommit{
 			Commit: commitUseCase,
 			GitHub: gitHub,
 		}
-		if err := u.Do(ctx, in); err != nil {
+		if _, err := u.Do(ctx, in); err != nil {
 			t.Errorf("err wants nil but %+v", err)
 		}
 	})
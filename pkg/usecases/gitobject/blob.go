@@ -0,0 +1,154 @@
+package gitobject
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+
+	"github.com/int128/ghcp/pkg/git"
+	"github.com/int128/ghcp/pkg/github"
+)
+
+// blobUpload represents a file to upload.
+type blobUpload struct {
+	File
+	lfsObject *git.LFSObject // set if the file is stored in Git LFS
+}
+
+// computeLFSObject returns the object of Git LFS for the file.
+func (u *CreateGitObject) computeLFSObject(file File) (*git.LFSObject, error) {
+	oid, err := u.FileSystem.ComputeSHA256(file.Path)
+	if err != nil {
+		return nil, fmt.Errorf("error while computing hash of file %s: %w", file.Path, err)
+	}
+	return &git.LFSObject{OID: oid, Size: file.Size}, nil
+}
+
+// computeBlobSHA returns SHA of the blob to be created from the file.
+// If the file is stored in Git LFS, it returns SHA of the pointer file.
+func (u *CreateGitObject) computeBlobSHA(upload blobUpload) (git.BlobSHA, error) {
+	if upload.lfsObject != nil {
+		return upload.lfsObject.PointerBlobSHA(), nil
+	}
+	blobSHA, err := u.FileSystem.ComputeGitBlobSHA(upload.Path)
+	if err != nil {
+		return "", fmt.Errorf("error while computing hash of file %s: %w", upload.Path, err)
+	}
+	return git.BlobSHA(blobSHA), nil
+}
+
+// uploadBlobs uploads the files concurrently up to the parallelism.
+// It returns SHA of the blobs in the same order as the files.
+//
+// If any upload fails, it cancels the outstanding uploads and
+// returns the errors of all failed files.
+func (u *CreateGitObject) uploadBlobs(ctx context.Context, repository git.RepositoryID, files []blobUpload, parallelism int) ([]git.BlobSHA, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	blobSHAs := make([]git.BlobSHA, len(files))
+	errs := make([]error, len(files))
+	semaphore := make(chan struct{}, max(parallelism, 1))
+	var wg sync.WaitGroup
+	for i, file := range files {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = fmt.Errorf("%s: %w", file.Path, ctx.Err())
+			continue
+		}
+		wg.Go(func() {
+			defer func() { <-semaphore }()
+			blobSHA, err := u.uploadBlob(ctx, repository, file)
+			if err != nil {
+				errs[i] = fmt.Errorf("%s: %w", file.Path, err)
+				cancel()
+				return
+			}
+			blobSHAs[i] = blobSHA
+			slog.Info("Uploaded", "file", file.Path, "filename", file.Filename, "blob", blobSHA)
+		})
+	}
+	wg.Wait()
+	if err := joinUploadErrors(errs); err != nil {
+		return nil, err
+	}
+	return blobSHAs, nil
+}
+
+func (u *CreateGitObject) uploadBlob(ctx context.Context, repository git.RepositoryID, file blobUpload) (git.BlobSHA, error) {
+	if file.lfsObject != nil {
+		return u.uploadLFSObject(ctx, repository, file.File, *file.lfsObject)
+	}
+	content, err := u.FileSystem.Open(file.Path)
+	if err != nil {
+		return "", fmt.Errorf("error while reading the file: %w", err)
+	}
+	defer func() {
+		if err := content.Close(); err != nil {
+			slog.Error("Failed to close the file", "error", err)
+		}
+	}()
+	blobSHA, err := u.GitHub.CreateBlob(ctx, git.NewBlob{
+		Repository: repository,
+		Content:    content,
+		Size:       file.Size,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error while creating a blob: %w", err)
+	}
+	return blobSHA, nil
+}
+
+// uploadLFSObject uploads the file to Git LFS and creates a blob of the pointer file.
+func (u *CreateGitObject) uploadLFSObject(ctx context.Context, repository git.RepositoryID, file File, lfsObject git.LFSObject) (git.BlobSHA, error) {
+	content, err := u.FileSystem.Open(file.Path)
+	if err != nil {
+		return "", fmt.Errorf("error while reading the file: %w", err)
+	}
+	defer func() {
+		if err := content.Close(); err != nil {
+			slog.Error("Failed to close the file", "error", err)
+		}
+	}()
+	if err := u.GitHub.UploadLFSObject(ctx, github.UploadLFSObjectInput{
+		Repository: repository,
+		Object:     lfsObject,
+		Content:    content,
+	}); err != nil {
+		return "", fmt.Errorf("error while uploading to Git LFS: %w", err)
+	}
+	slog.Debug("Uploaded to Git LFS", "file", file.Path, "oid", lfsObject.OID)
+	pointer := lfsObject.Pointer()
+	blobSHA, err := u.GitHub.CreateBlob(ctx, git.NewBlob{
+		Repository: repository,
+		Content:    strings.NewReader(pointer),
+		Size:       int64(len(pointer)),
+	})
+	if err != nil {
+		return "", fmt.Errorf("error while creating a blob of the pointer file: %w", err)
+	}
+	return blobSHA, nil
+}
+
+// joinUploadErrors returns the errors of the failed files.
+// The errors caused by the cancellation are omitted if any other error exists.
+func joinUploadErrors(errs []error) error {
+	var failed, canceled []error
+	for _, err := range errs {
+		switch {
+		case err == nil:
+		case errors.Is(err, context.Canceled):
+			canceled = append(canceled, err)
+		default:
+			failed = append(failed, err)
+		}
+	}
+	if len(failed) > 0 {
+		return errors.Join(failed...)
+	}
+	return errors.Join(canceled...)
+}
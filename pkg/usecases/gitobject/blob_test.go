@@ -0,0 +1,139 @@
+package gitobject
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	gogithub "github.com/google/go-github/v88/github"
+
+	"github.com/int128/ghcp/pkg/fs"
+	"github.com/int128/ghcp/pkg/git"
+	"github.com/int128/ghcp/pkg/github"
+	"github.com/int128/ghcp/pkg/github/client"
+)
+
+// fakeBlobClient counts the in-flight requests to create a blob.
+// It returns the content as SHA of a blob.
+type fakeBlobClient struct {
+	client.Interface // not implemented
+
+	failContent string // if set, fail the request of the content
+
+	mu          sync.Mutex
+	inFlight    int
+	maxInFlight int
+}
+
+func (c *fakeBlobClient) CreateBlobFromReader(ctx context.Context, _ string, _ string, r io.Reader, _ int64) (*gogithub.Blob, *gogithub.Response, error) {
+	c.mu.Lock()
+	c.inFlight++
+	c.maxInFlight = max(c.maxInFlight, c.inFlight)
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		c.inFlight--
+		c.mu.Unlock()
+	}()
+
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not read the content: %w", err)
+	}
+	if string(content) == c.failContent {
+		return nil, nil, errors.New("internal server error")
+	}
+	select {
+	case <-time.After(50 * time.Millisecond):
+		return &gogithub.Blob{SHA: gogithub.Ptr(string(content))}, nil, nil
+	case <-ctx.Done():
+		return nil, nil, ctx.Err()
+	}
+}
+
+func createFiles(t *testing.T, n int) []blobUpload {
+	tempDir := t.TempDir()
+	var files []blobUpload
+	for i := range n {
+		name := fmt.Sprintf("file%d", i)
+		path := filepath.Join(tempDir, name)
+		if err := os.WriteFile(path, []byte(name), 0644); err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, blobUpload{File: File{Path: path, Filename: name, Size: int64(len(name))}})
+	}
+	return files
+}
+
+func TestCreateGitObject_uploadBlobs(t *testing.T) {
+	ctx := context.TODO()
+	repositoryID := git.RepositoryID{Owner: "owner", Name: "repo"}
+
+	t.Run("Parallelism", func(t *testing.T) {
+		gitHubClient := &fakeBlobClient{}
+		useCase := CreateGitObject{
+			FileSystem: &fs.FileSystem{},
+			GitHub:     &github.GitHub{Client: gitHubClient},
+		}
+		files := createFiles(t, 10)
+		blobSHAs, err := useCase.uploadBlobs(ctx, repositoryID, files, 3)
+		if err != nil {
+			t.Fatalf("uploadBlobs returned error: %+v", err)
+		}
+		for i, blobSHA := range blobSHAs {
+			if want := git.BlobSHA(files[i].Filename); blobSHA != want {
+				t.Errorf("blobSHAs[%d] wants %s but %s", i, want, blobSHA)
+			}
+		}
+		if gitHubClient.maxInFlight > 3 {
+			t.Errorf("maxInFlight wants <= 3 but %d", gitHubClient.maxInFlight)
+		}
+		if gitHubClient.maxInFlight < 2 {
+			t.Errorf("maxInFlight wants >= 2 but %d", gitHubClient.maxInFlight)
+		}
+	})
+
+	t.Run("Sequential", func(t *testing.T) {
+		gitHubClient := &fakeBlobClient{}
+		useCase := CreateGitObject{
+			FileSystem: &fs.FileSystem{},
+			GitHub:     &github.GitHub{Client: gitHubClient},
+		}
+		files := createFiles(t, 3)
+		if _, err := useCase.uploadBlobs(ctx, repositoryID, files, 0); err != nil {
+			t.Fatalf("uploadBlobs returned error: %+v", err)
+		}
+		if gitHubClient.maxInFlight != 1 {
+			t.Errorf("maxInFlight wants 1 but %d", gitHubClient.maxInFlight)
+		}
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		gitHubClient := &fakeBlobClient{failContent: "file1"}
+		useCase := CreateGitObject{
+			FileSystem: &fs.FileSystem{},
+			GitHub:     &github.GitHub{Client: gitHubClient},
+		}
+		files := createFiles(t, 10)
+		blobSHAs, err := useCase.uploadBlobs(ctx, repositoryID, files, 3)
+		if blobSHAs != nil {
+			t.Errorf("blobSHAs wants nil but %v", blobSHAs)
+		}
+		if err == nil {
+			t.Fatalf("err wants non-nil but nil")
+		}
+		if !strings.Contains(err.Error(), files[1].Path) {
+			t.Errorf("err wants to contain %s but %s", files[1].Path, err)
+		}
+		if errors.Is(err, context.Canceled) {
+			t.Errorf("err wants not to contain the cancellation but %s", err)
+		}
+	})
+}
@@ -0,0 +1,146 @@
+package gitobject
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/int128/ghcp/pkg/git"
+	"github.com/int128/ghcp/pkg/github"
+)
+
+// CommitOnBranchInput represents a commit to create by the GraphQL API.
+type CommitOnBranchInput struct {
+	Files            []File   // nil or empty to create an empty commit
+	DeletedFiles     []string // paths in the repository to delete
+	Repository       git.RepositoryID
+	RepositoryNodeID github.InternalRepositoryNodeID // required if CreateBranch is set
+	BranchName       git.BranchName
+	CreateBranch     bool // create the branch at the parent commit before committing, and delete it if the commit failed
+	CommitMessage    git.CommitMessage
+	ParentCommitSHA  git.CommitSHA // head of the branch
+	ParentTreeSHA    git.TreeSHA
+	ParentRepository git.RepositoryID // repository of the parent tree (default: Repository)
+}
+
+// parentRepository returns the repository to read the parent tree from.
+func (in CommitOnBranchInput) parentRepository() git.RepositoryID {
+	if in.ParentRepository.IsValid() {
+		return in.ParentRepository
+	}
+	return in.Repository
+}
+
+// CommitOnBranch creates a commit on the branch by the GraphQL createCommitOnBranch mutation.
+// It sends the changed files in a single request and the branch is updated at once.
+// It does not support the file mode, author, committer or Git LFS.
+// The executable bit of the files is ignored, and it returns an error if any file is in Git LFS.
+func (u *CreateGitObject) CommitOnBranch(ctx context.Context, in CommitOnBranchInput) (*Output, error) {
+	if err := validateFileSizes(in.Files); err != nil {
+		return nil, err
+	}
+	var parentFiles map[string]git.File
+	if len(in.Files) > 0 || len(in.DeletedFiles) > 0 {
+		var err error
+		parentFiles, err = u.getParentFilesIfSet(ctx, in.parentRepository(), in.ParentTreeSHA)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting the parent tree: %w", err)
+		}
+	}
+
+	var additions []github.FileAddition
+	for _, file := range in.Files {
+		if file.LFS {
+			return nil, fmt.Errorf("file %s is in Git LFS, which is not supported by the GraphQL API", file.Path)
+		}
+		if parentFiles != nil {
+			localBlobSHA, err := u.computeBlobSHA(blobUpload{File: file})
+			if err != nil {
+				return nil, err
+			}
+			parentFile, exists := parentFiles[file.Filename]
+			if exists && parentFile.BlobSHA == localBlobSHA {
+				slog.Debug("Skip the file same as the parent tree", "file", file.Path, "filename", file.Filename)
+				continue
+			}
+		}
+		content, err := u.readFile(file.Path)
+		if err != nil {
+			return nil, err
+		}
+		additions = append(additions, github.FileAddition{Filename: file.Filename, Content: content})
+	}
+	deletions := expandDeletedFiles(in.DeletedFiles, parentFiles)
+	for _, filename := range deletions {
+		slog.Info("Deleting", "filename", filename)
+	}
+	if len(in.Files)+len(in.DeletedFiles) > 0 && len(additions)+len(deletions) == 0 {
+		slog.Info("Nothing to commit because the parent tree has the same files", "tree", in.ParentTreeSHA)
+		return &Output{}, nil
+	}
+
+	if in.CreateBranch {
+		if err := u.GitHub.CreateBranch(ctx, github.CreateBranchInput{
+			RepositoryNodeID: in.RepositoryNodeID,
+			BranchName:       in.BranchName,
+			CommitSHA:        in.ParentCommitSHA,
+		}); err != nil {
+			return nil, fmt.Errorf("error while creating %s branch: %w", in.BranchName, err)
+		}
+		slog.Info("Created a branch", "branch", in.BranchName, "commit", in.ParentCommitSHA)
+	}
+	commitSHA, err := u.GitHub.CreateCommitOnBranch(ctx, github.CreateCommitOnBranchInput{
+		Repository:      in.Repository,
+		BranchName:      in.BranchName,
+		ExpectedHeadOid: in.ParentCommitSHA,
+		Message:         in.CommitMessage,
+		Additions:       additions,
+		Deletions:       deletions,
+	})
+	if err != nil {
+		if in.CreateBranch {
+			// do not leave the branch at the parent commit
+			if err := u.deleteBranch(ctx, in); err != nil {
+				slog.Warn("Could not delete the branch created for the commit", "branch", in.BranchName, "error", err)
+			}
+		}
+		return nil, fmt.Errorf("error while creating a commit on %s branch: %w", in.BranchName, err)
+	}
+	slog.Info("Created commit on the branch", "sha", commitSHA, "branch", in.BranchName)
+	return &Output{
+		CommitSHA:    commitSHA,
+		ChangedFiles: len(additions) + len(deletions),
+		DeletedFiles: len(deletions),
+	}, nil
+}
+
+// deleteBranch deletes the branch created at the parent commit.
+func (u *CreateGitObject) deleteBranch(ctx context.Context, in CommitOnBranchInput) error {
+	if err := u.GitHub.DeleteBranch(ctx, github.DeleteBranchInput{
+		RepositoryNodeID:  in.RepositoryNodeID,
+		BranchName:        in.BranchName,
+		ExpectedCommitSHA: in.ParentCommitSHA,
+	}); err != nil {
+		return err
+	}
+	slog.Info("Deleted the branch created for the commit", "branch", in.BranchName)
+	return nil
+}
+
+func (u *CreateGitObject) readFile(filename string) ([]byte, error) {
+	r, err := u.FileSystem.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error while opening file %s: %w", filename, err)
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			slog.Error("Failed to close the file", "error", err)
+		}
+	}()
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("error while reading file %s: %w", filename, err)
+	}
+	return content, nil
+}
@@ -0,0 +1,212 @@
+package gitobject
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/int128/ghcp/mocks/github.com/int128/ghcp/pkg/fs_mock"
+	"github.com/int128/ghcp/mocks/github.com/int128/ghcp/pkg/github_mock"
+	"github.com/int128/ghcp/pkg/git"
+	"github.com/int128/ghcp/pkg/github"
+)
+
+func TestCreateGitObject_CommitOnBranch(t *testing.T) {
+	ctx := context.TODO()
+	repositoryID := git.RepositoryID{Owner: "owner", Name: "repo"}
+	repositoryNodeID := github.InternalRepositoryNodeID("OwnerRepo")
+
+	t.Run("BasicOptions", func(t *testing.T) {
+		fileSystem := fs_mock.NewMockInterface(t)
+		fileSystem.EXPECT().
+			ComputeGitBlobSHA("file1").
+			Return("localBlobSHA1", nil)
+		fileSystem.EXPECT().
+			ComputeGitBlobSHA("file2").
+			Return("blobSHA2", nil)
+		fileSystem.EXPECT().
+			Open("file1").
+			Return(io.NopCloser(strings.NewReader("content1")), nil)
+
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			GetTree(ctx, repositoryID, git.TreeSHA("masterTreeSHA")).
+			Return(&git.Tree{
+				SHA: "masterTreeSHA",
+				Files: []git.File{
+					{Filename: "file2", BlobSHA: "blobSHA2"},
+					{Filename: "file3", BlobSHA: "blobSHA3"},
+				},
+			}, nil)
+		gitHub.EXPECT().
+			CreateCommitOnBranch(ctx, github.CreateCommitOnBranchInput{
+				Repository:      repositoryID,
+				BranchName:      "topic",
+				ExpectedHeadOid: "masterCommitSHA",
+				Message:         "message",
+				Additions: []github.FileAddition{
+					{Filename: "file1", Content: []byte("content1")},
+				},
+				Deletions: []string{"file3"},
+			}).
+			Return(git.CommitSHA("commitSHA"), nil)
+
+		useCase := CreateGitObject{
+			FileSystem: fileSystem,
+			GitHub:     gitHub,
+		}
+		got, err := useCase.CommitOnBranch(ctx, CommitOnBranchInput{
+			Files: []File{
+				{Path: "file1", Filename: "file1", Size: 8},
+				{Path: "file2", Filename: "file2", Size: 8},
+			},
+			DeletedFiles:    []string{"file3", "file4"},
+			Repository:      repositoryID,
+			BranchName:      "topic",
+			CommitMessage:   "message",
+			ParentCommitSHA: "masterCommitSHA",
+			ParentTreeSHA:   "masterTreeSHA",
+		})
+		if err != nil {
+			t.Fatalf("CommitOnBranch returned error: %+v", err)
+		}
+		want := &Output{
+			CommitSHA:    "commitSHA",
+			ChangedFiles: 2,
+			DeletedFiles: 1,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("CreateBranch", func(t *testing.T) {
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			CreateBranch(ctx, github.CreateBranchInput{
+				RepositoryNodeID: repositoryNodeID,
+				BranchName:       "topic",
+				CommitSHA:        "masterCommitSHA",
+			}).
+			Return(nil)
+		gitHub.EXPECT().
+			CreateCommitOnBranch(ctx, github.CreateCommitOnBranchInput{
+				Repository:      repositoryID,
+				BranchName:      "topic",
+				ExpectedHeadOid: "masterCommitSHA",
+				Message:         "message",
+			}).
+			Return(git.CommitSHA("commitSHA"), nil)
+
+		useCase := CreateGitObject{
+			FileSystem: fs_mock.NewMockInterface(t),
+			GitHub:     gitHub,
+		}
+		got, err := useCase.CommitOnBranch(ctx, CommitOnBranchInput{
+			Repository:       repositoryID,
+			RepositoryNodeID: repositoryNodeID,
+			BranchName:       "topic",
+			CreateBranch:     true,
+			CommitMessage:    "message",
+			ParentCommitSHA:  "masterCommitSHA",
+			ParentTreeSHA:    "masterTreeSHA",
+		})
+		if err != nil {
+			t.Fatalf("CommitOnBranch returned error: %+v", err)
+		}
+		want := &Output{CommitSHA: "commitSHA"}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("CreateBranchAndFailToCommit", func(t *testing.T) {
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			CreateBranch(ctx, github.CreateBranchInput{
+				RepositoryNodeID: repositoryNodeID,
+				BranchName:       "topic",
+				CommitSHA:        "masterCommitSHA",
+			}).
+			Return(nil)
+		gitHub.EXPECT().
+			CreateCommitOnBranch(ctx, github.CreateCommitOnBranchInput{
+				Repository:      repositoryID,
+				BranchName:      "topic",
+				ExpectedHeadOid: "masterCommitSHA",
+				Message:         "message",
+			}).
+			Return("", errors.New("validation error"))
+		// the branch should not be left at the parent commit
+		gitHub.EXPECT().
+			DeleteBranch(ctx, github.DeleteBranchInput{
+				RepositoryNodeID:  repositoryNodeID,
+				BranchName:        "topic",
+				ExpectedCommitSHA: "masterCommitSHA",
+			}).
+			Return(nil)
+
+		useCase := CreateGitObject{
+			FileSystem: fs_mock.NewMockInterface(t),
+			GitHub:     gitHub,
+		}
+		_, err := useCase.CommitOnBranch(ctx, CommitOnBranchInput{
+			Repository:       repositoryID,
+			RepositoryNodeID: repositoryNodeID,
+			BranchName:       "topic",
+			CreateBranch:     true,
+			CommitMessage:    "message",
+			ParentCommitSHA:  "masterCommitSHA",
+			ParentTreeSHA:    "masterTreeSHA",
+		})
+		if err == nil {
+			t.Fatalf("err wants non-nil but nil")
+		}
+	})
+
+	t.Run("ExpectedHeadMismatch", func(t *testing.T) {
+		fileSystem := fs_mock.NewMockInterface(t)
+		fileSystem.EXPECT().
+			ComputeGitBlobSHA("file1").
+			Return("localBlobSHA1", nil)
+		fileSystem.EXPECT().
+			Open("file1").
+			Return(io.NopCloser(strings.NewReader("content1")), nil)
+
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			GetTree(ctx, repositoryID, git.TreeSHA("masterTreeSHA")).
+			Return(&git.Tree{SHA: "masterTreeSHA"}, nil)
+		gitHub.EXPECT().
+			CreateCommitOnBranch(ctx, github.CreateCommitOnBranchInput{
+				Repository:      repositoryID,
+				BranchName:      "topic",
+				ExpectedHeadOid: "masterCommitSHA",
+				Message:         "message",
+				Additions: []github.FileAddition{
+					{Filename: "file1", Content: []byte("content1")},
+				},
+			}).
+			Return("", fmt.Errorf("GitHub API error: %w", github.ErrNotFastForward))
+
+		useCase := CreateGitObject{
+			FileSystem: fileSystem,
+			GitHub:     gitHub,
+		}
+		_, err := useCase.CommitOnBranch(ctx, CommitOnBranchInput{
+			Files:           []File{{Path: "file1", Filename: "file1", Size: 8}},
+			Repository:      repositoryID,
+			BranchName:      "topic",
+			CommitMessage:   "message",
+			ParentCommitSHA: "masterCommitSHA",
+			ParentTreeSHA:   "masterTreeSHA",
+		})
+		if !errors.Is(err, github.ErrNotFastForward) {
+			t.Errorf("err wants ErrNotFastForward but %+v", err)
+		}
+	})
+}
@@ -3,14 +3,20 @@ package gitobject
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"path"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/google/wire"
 
 	"github.com/int128/ghcp/pkg/fs"
 	"github.com/int128/ghcp/pkg/git"
 	"github.com/int128/ghcp/pkg/github"
+	"github.com/int128/ghcp/pkg/signer"
 )
 
 var Set = wire.NewSet(
@@ -20,48 +26,100 @@ var Set = wire.NewSet(
 
 type Interface interface {
 	Do(ctx context.Context, in Input) (*Output, error)
+	CommitOnBranch(ctx context.Context, in CommitOnBranchInput) (*Output, error)
+	Plan(ctx context.Context, in Input) (*Changes, error)
 }
 
 type Input struct {
-	Files           []fs.File // nil or empty to create an empty commit
-	Repository      git.RepositoryID
-	CommitMessage   git.CommitMessage
-	Author          *git.CommitAuthor // optional
-	Committer       *git.CommitAuthor // optional
-	ParentCommitSHA git.CommitSHA     // no parent if empty
-	ParentTreeSHA   git.TreeSHA       // no parent if empty
-	NoFileMode      bool
+	Files            []File   // nil or empty to create an empty commit
+	DeletedFiles     []string // paths in the repository to delete
+	Repository       git.RepositoryID
+	CommitMessage    git.CommitMessage
+	Author           *git.CommitAuthor // optional
+	Committer        *git.CommitAuthor // optional
+	ParentCommitSHA  git.CommitSHA     // no parent if empty
+	MergeParentSHAs  []git.CommitSHA   // parents after ParentCommitSHA to create a merge commit (optional)
+	ParentTreeSHA    git.TreeSHA       // no parent if empty
+	ParentRepository git.RepositoryID  // repository of the parent tree (default: Repository)
+	NoFileMode       bool
+	Parallelism      int                    // number of blobs to upload concurrently (default: 1)
+	UploadedBlobs    map[string]git.BlobSHA // blobs uploaded in a previous attempt by the local path (optional)
+	SigningKey       *git.SigningKey        // sign the commit if set (optional)
+}
+
+// parentRepository returns the repository to read the parent tree from.
+func (in Input) parentRepository() git.RepositoryID {
+	if in.ParentRepository.IsValid() {
+		return in.ParentRepository
+	}
+	return in.Repository
+}
+
+// File represents a local file to be committed to the repository.
+type File struct {
+	Path       string // path of the local file
+	Filename   string // path in the repository
+	Executable bool
+	Size       int64
+	LFS        bool        // upload the content to Git LFS and commit the pointer file
+	BlobSHA    git.BlobSHA // existing blob in the repository, instead of the content of the local file (optional)
 }
 
 type Output struct {
-	CommitSHA    git.CommitSHA
-	ChangedFiles int
+	CommitSHA     git.CommitSHA
+	ChangedFiles  int
+	DeletedFiles  int
+	UploadedBlobs map[string]git.BlobSHA // blobs of the files in the tree by the local path
 }
 
 // CreateGitObject creates blob(s), a tree and a commit.
 type CreateGitObject struct {
 	FileSystem fs.Interface
 	GitHub     github.Interface
+	Signer     signer.Interface
 }
 
 func (u *CreateGitObject) Do(ctx context.Context, in Input) (*Output, error) {
-	treeSHA, err := u.uploadFilesIfSet(ctx, in)
+	tree, err := u.uploadFilesIfSet(ctx, in)
 	if err != nil {
 		return nil, fmt.Errorf("error while creating a tree: %w", err)
 	}
+	if tree.unchanged && len(in.MergeParentSHAs) == 0 {
+		slog.Info("Nothing to commit because the parent tree has the same files", "tree", in.ParentTreeSHA)
+		return &Output{}, nil
+	}
 
-	commitSHA, err := u.GitHub.CreateCommit(ctx, git.NewCommit{
+	newCommit := git.NewCommit{
 		Repository:      in.Repository,
 		Message:         in.CommitMessage,
 		Author:          in.Author,
 		Committer:       in.Committer,
 		ParentCommitSHA: in.ParentCommitSHA,
-		TreeSHA:         treeSHA,
-	})
+		MergeParentSHAs: in.MergeParentSHAs,
+		TreeSHA:         tree.sha,
+	}
+	if in.SigningKey != nil {
+		newCommit, err = u.signCommit(ctx, newCommit, *in.SigningKey)
+		if err != nil {
+			return nil, fmt.Errorf("error while signing the commit: %w", err)
+		}
+	}
+	commitSHA, err := u.GitHub.CreateCommit(ctx, newCommit)
 	if err != nil {
 		return nil, fmt.Errorf("error while creating a commit: %w", err)
 	}
 	slog.Info("Created commit", "sha", commitSHA)
+	if in.SigningKey != nil {
+		// if GitHub created a different object, the signature is not valid for it
+		signedSHA, err := newCommit.SHA()
+		if err != nil {
+			return nil, fmt.Errorf("error while computing the commit hash: %w", err)
+		}
+		if commitSHA != signedSHA {
+			return nil, fmt.Errorf("created commit %s does not match the signed commit %s", commitSHA, signedSHA)
+		}
+		slog.Debug("Created commit matches the signed commit", "sha", commitSHA)
+	}
 
 	commit, err := u.GitHub.QueryCommit(ctx, github.QueryCommitInput{
 		Repository: in.Repository,
@@ -72,37 +130,151 @@ func (u *CreateGitObject) Do(ctx context.Context, in Input) (*Output, error) {
 	}
 
 	return &Output{
-		CommitSHA:    commitSHA,
-		ChangedFiles: commit.ChangedFiles,
+		CommitSHA:     commitSHA,
+		ChangedFiles:  commit.ChangedFiles,
+		DeletedFiles:  tree.deletedFiles,
+		UploadedBlobs: tree.uploadedBlobs,
 	}, nil
 }
 
-func (u *CreateGitObject) uploadFilesIfSet(ctx context.Context, in Input) (git.TreeSHA, error) {
-	if len(in.Files) == 0 {
+// signCommit returns the commit with the signature.
+// The author is required, because the signed content must be same as the commit created by GitHub.
+// If the committer is not set, it is same as the author.
+// If the dates are not set, they are set to the current time.
+func (u *CreateGitObject) signCommit(ctx context.Context, c git.NewCommit, key git.SigningKey) (git.NewCommit, error) {
+	if c.Author == nil {
+		return git.NewCommit{}, errors.New("author is required to sign a commit")
+	}
+	now := time.Now().Truncate(time.Second)
+	author := *c.Author
+	if author.Date.IsZero() {
+		author.Date = now
+	}
+	committer := author
+	if c.Committer != nil {
+		committer = *c.Committer
+		if committer.Date.IsZero() {
+			committer.Date = now
+		}
+	}
+	c.Author, c.Committer = &author, &committer
+	payload, err := c.Payload()
+	if err != nil {
+		return git.NewCommit{}, err
+	}
+	signature, err := u.Signer.Sign(ctx, key, payload)
+	if err != nil {
+		return git.NewCommit{}, err
+	}
+	c.Signature = signature
+	slog.Info("Signed the commit", "format", key.Format)
+	return c, nil
+}
+
+type uploadedTree struct {
+	sha           git.TreeSHA
+	unchanged     bool // true if all files are same as the parent tree
+	deletedFiles  int
+	uploadedBlobs map[string]git.BlobSHA
+}
+
+func (u *CreateGitObject) uploadFilesIfSet(ctx context.Context, in Input) (*uploadedTree, error) {
+	if len(in.Files) == 0 && len(in.DeletedFiles) == 0 {
 		slog.Debug("Using the parent tree", "tree", in.ParentTreeSHA)
-		return in.ParentTreeSHA, nil
+		return &uploadedTree{sha: in.ParentTreeSHA}, nil
+	}
+	if err := validateFileSizes(in.Files); err != nil {
+		return nil, err
+	}
+	parentFiles, err := u.getParentFilesIfSet(ctx, in.parentRepository(), in.ParentTreeSHA)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting the parent tree: %w", err)
 	}
 
-	files := make([]git.File, len(in.Files))
+	entries := make([]*git.File, len(in.Files)) // nil if the file is same as the parent tree
+	var uploads []blobUpload
+	var uploadIndexes []int // indexes of the files to upload
 	for i, file := range in.Files {
-		content, err := u.FileSystem.ReadAsBase64EncodedContent(file.Path)
-		if err != nil {
-			return "", fmt.Errorf("error while reading file %s: %w", file.Path, err)
+		executable := !in.NoFileMode && file.Executable
+		upload := blobUpload{File: file}
+		uploadedBlobSHA, uploaded := in.UploadedBlobs[file.Path]
+		if file.BlobSHA != "" {
+			uploadedBlobSHA, uploaded = file.BlobSHA, true
 		}
-		blobSHA, err := u.GitHub.CreateBlob(ctx, git.NewBlob{
-			Repository: in.Repository,
-			Content:    content,
-		})
-		if err != nil {
-			return "", fmt.Errorf("error while creating a blob for %s: %w", file.Path, err)
+		if file.LFS && !uploaded {
+			lfsObject, err := u.computeLFSObject(file)
+			if err != nil {
+				return nil, err
+			}
+			upload.lfsObject = lfsObject
+		}
+		if parentFiles != nil {
+			localBlobSHA := uploadedBlobSHA
+			if !uploaded {
+				localBlobSHA, err = u.computeBlobSHA(upload)
+				if err != nil {
+					return nil, err
+				}
+			}
+			parentFile, exists := parentFiles[file.Filename]
+			if exists && parentFile.BlobSHA == localBlobSHA {
+				if parentFile.Executable == executable {
+					slog.Debug("Skip the file same as the parent tree", "file", file.Path, "filename", file.Filename)
+					continue
+				}
+				// the blob already exists in the repository
+				entries[i] = &git.File{
+					Filename:   file.Filename,
+					BlobSHA:    parentFile.BlobSHA,
+					Executable: executable,
+				}
+				slog.Info("Changing the file mode", "file", file.Path, "filename", file.Filename)
+				continue
+			}
+		}
+		if uploaded {
+			entries[i] = &git.File{
+				Filename:   file.Filename,
+				BlobSHA:    uploadedBlobSHA,
+				Executable: executable,
+			}
+			slog.Debug("Reusing the blob uploaded in the previous attempt", "file", file.Path, "blob", uploadedBlobSHA)
+			continue
+		}
+		entries[i] = &git.File{
+			Filename:   file.Filename,
+			Executable: executable,
 		}
-		gitFile := git.File{
-			Filename:   file.Path,
-			BlobSHA:    blobSHA,
-			Executable: !in.NoFileMode && file.Executable,
+		uploads = append(uploads, upload)
+		uploadIndexes = append(uploadIndexes, i)
+	}
+	blobSHAs, err := u.uploadBlobs(ctx, in.Repository, uploads, in.Parallelism)
+	if err != nil {
+		return nil, fmt.Errorf("error while uploading files: %w", err)
+	}
+	for i, index := range uploadIndexes {
+		entries[index].BlobSHA = blobSHAs[i]
+	}
+
+	var files []git.File
+	uploadedBlobs := make(map[string]git.BlobSHA)
+	for i, entry := range entries {
+		if entry != nil {
+			files = append(files, *entry)
+			uploadedBlobs[in.Files[i].Path] = entry.BlobSHA
 		}
-		files[i] = gitFile
-		slog.Info("Uploaded", "file", file.Path, "blob", blobSHA)
+	}
+	var deletedFiles int
+	for _, filename := range expandDeletedFiles(in.DeletedFiles, parentFiles) {
+		files = append(files, git.File{
+			Filename: filename,
+			Deleted:  true,
+		})
+		deletedFiles++
+		slog.Info("Deleting", "filename", filename)
+	}
+	if len(files) == 0 {
+		return &uploadedTree{sha: in.ParentTreeSHA, unchanged: true}, nil
 	}
 
 	treeSHA, err := u.GitHub.CreateTree(ctx, git.NewTree{
@@ -111,8 +283,84 @@ func (u *CreateGitObject) uploadFilesIfSet(ctx context.Context, in Input) (git.T
 		Files:       files,
 	})
 	if err != nil {
-		return "", fmt.Errorf("error while creating a tree: %w", err)
+		return nil, fmt.Errorf("error while creating a tree: %w", err)
 	}
 	slog.Info("Created a tree", "tree", treeSHA)
-	return treeSHA, nil
+	return &uploadedTree{sha: treeSHA, deletedFiles: deletedFiles, uploadedBlobs: uploadedBlobs}, nil
+}
+
+// validateFileSizes returns an error if any file exceeds the limit of blob size.
+// This prevents a partial upload of the files.
+// A file in Git LFS is not limited, because the blob is the pointer file.
+func validateFileSizes(files []File) error {
+	var errs []error
+	for _, file := range files {
+		if !file.LFS && file.Size > github.MaxBlobSize {
+			errs = append(errs, fmt.Errorf("file %s is too large (%d bytes, limit %d bytes)", file.Path, file.Size, github.MaxBlobSize))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// getParentFilesIfSet returns the files in the parent tree by the filename.
+// It returns nil if the parent tree is not set or too large,
+// and then all files should be uploaded.
+func (u *CreateGitObject) getParentFilesIfSet(ctx context.Context, repository git.RepositoryID, parentTreeSHA git.TreeSHA) (map[string]git.File, error) {
+	if parentTreeSHA == "" {
+		return nil, nil
+	}
+	tree, err := u.GitHub.GetTree(ctx, repository, parentTreeSHA)
+	if errors.Is(err, github.ErrTreeTruncated) {
+		slog.Warn("Uploading all files because the parent tree is too large to compare", "tree", parentTreeSHA)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not get the tree %s: %w", parentTreeSHA, err)
+	}
+	parentFiles := make(map[string]git.File, len(tree.Files))
+	for _, file := range tree.Files {
+		parentFiles[file.Filename] = file
+	}
+	return parentFiles, nil
+}
+
+// expandDeletedFiles returns the filenames to delete in the parent tree.
+// A path is cleaned before matching, e.g., docs/ or ./docs to docs.
+// A path of a directory is expanded to the files under it.
+// A path which does not exist in the parent tree is skipped with a warning.
+// If the parent tree is not available, it returns the cleaned paths.
+func expandDeletedFiles(paths []string, parentFiles map[string]git.File) []string {
+	var filenames []string
+	seen := make(map[string]bool)
+	for _, p := range paths {
+		p = path.Clean(p)
+		if p == "." || p == ".." || strings.HasPrefix(p, "../") || path.IsAbs(p) {
+			slog.Warn("Skip the path to delete which is not in the repository", "path", p)
+			continue
+		}
+		var matched []string
+		if parentFiles == nil {
+			matched = append(matched, p)
+		} else if _, exists := parentFiles[p]; exists {
+			matched = append(matched, p)
+		} else {
+			for filename := range parentFiles {
+				if strings.HasPrefix(filename, p+"/") {
+					matched = append(matched, filename)
+				}
+			}
+			slices.Sort(matched)
+		}
+		if len(matched) == 0 {
+			slog.Warn("Skip the path to delete which does not exist in the parent tree", "path", p)
+			continue
+		}
+		for _, filename := range matched {
+			if !seen[filename] {
+				seen[filename] = true
+				filenames = append(filenames, filename)
+			}
+		}
+	}
+	return filenames
 }
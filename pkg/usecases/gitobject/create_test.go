@@ -2,14 +2,19 @@ package gitobject
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/int128/ghcp/mocks/github.com/int128/ghcp/pkg/fs_mock"
 	"github.com/int128/ghcp/mocks/github.com/int128/ghcp/pkg/github_mock"
-	"github.com/int128/ghcp/pkg/fs"
+	"github.com/int128/ghcp/mocks/github.com/int128/ghcp/pkg/signer_mock"
 	"github.com/int128/ghcp/pkg/git"
 	"github.com/int128/ghcp/pkg/github"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestCreateBlobTreeCommit_Do(t *testing.T) {
@@ -20,23 +25,34 @@ func TestCreateBlobTreeCommit_Do(t *testing.T) {
 
 		fileSystem := fs_mock.NewMockInterface(t)
 		fileSystem.EXPECT().
-			ReadAsBase64EncodedContent("file1").
-			Return("base64content1", nil)
+			ComputeGitBlobSHA("file1").
+			Return("localBlobSHA1", nil)
 		fileSystem.EXPECT().
-			ReadAsBase64EncodedContent("file2").
-			Return("base64content2", nil)
+			ComputeGitBlobSHA("file2").
+			Return("localBlobSHA2", nil)
+		fileSystem.EXPECT().
+			Open("file1").
+			Return(io.NopCloser(strings.NewReader("content1")), nil)
+		fileSystem.EXPECT().
+			Open("file2").
+			Return(io.NopCloser(strings.NewReader("content2")), nil)
 
 		gitHub := github_mock.NewMockInterface(t)
 		gitHub.EXPECT().
-			CreateBlob(ctx, git.NewBlob{
+			GetTree(ctx, repositoryID, git.TreeSHA("masterTreeSHA")).
+			Return(&git.Tree{SHA: "masterTreeSHA"}, nil)
+		gitHub.EXPECT().
+			CreateBlob(mock.Anything, git.NewBlob{
 				Repository: repositoryID,
-				Content:    "base64content1",
+				Content:    io.NopCloser(strings.NewReader("content1")),
+				Size:       8,
 			}).
 			Return(git.BlobSHA("blobSHA1"), nil)
 		gitHub.EXPECT().
-			CreateBlob(ctx, git.NewBlob{
+			CreateBlob(mock.Anything, git.NewBlob{
 				Repository: repositoryID,
-				Content:    "base64content2",
+				Content:    io.NopCloser(strings.NewReader("content2")),
+				Size:       8,
 			}).
 			Return(git.BlobSHA("blobSHA2"), nil)
 		gitHub.EXPECT().
@@ -77,9 +93,9 @@ func TestCreateBlobTreeCommit_Do(t *testing.T) {
 			GitHub:     gitHub,
 		}
 		got, err := useCase.Do(ctx, Input{
-			Files: []fs.File{
-				{Path: "file1"},
-				{Path: "file2", Executable: true},
+			Files: []File{
+				{Path: "file1", Filename: "file1", Size: 8},
+				{Path: "file2", Filename: "file2", Executable: true, Size: 8},
 			},
 			Repository:      repositoryID,
 			CommitMessage:   "message",
@@ -90,8 +106,9 @@ func TestCreateBlobTreeCommit_Do(t *testing.T) {
 			t.Fatalf("Do returned error: %+v", err)
 		}
 		want := &Output{
-			CommitSHA:    "commitSHA",
-			ChangedFiles: 1,
+			CommitSHA:     "commitSHA",
+			ChangedFiles:  1,
+			UploadedBlobs: map[string]git.BlobSHA{"file1": "blobSHA1", "file2": "blobSHA2"},
 		}
 		if diff := cmp.Diff(want, got); diff != "" {
 			t.Errorf("mismatch (-want +got):\n%s", diff)
@@ -102,23 +119,32 @@ func TestCreateBlobTreeCommit_Do(t *testing.T) {
 
 		fileSystem := fs_mock.NewMockInterface(t)
 		fileSystem.EXPECT().
-			ReadAsBase64EncodedContent("file1").
-			Return("base64content1", nil)
+			ComputeGitBlobSHA("file1").
+			Return("localBlobSHA1", nil)
+		fileSystem.EXPECT().
+			ComputeGitBlobSHA("file2").
+			Return("localBlobSHA2", nil)
 		fileSystem.EXPECT().
-			ReadAsBase64EncodedContent("file2").
-			Return("base64content2", nil)
+			Open("file1").
+			Return(io.NopCloser(strings.NewReader("content1")), nil)
+		fileSystem.EXPECT().
+			Open("file2").
+			Return(io.NopCloser(strings.NewReader("content2")), nil)
 
 		gitHub := github_mock.NewMockInterface(t)
 		gitHub.EXPECT().
-			CreateBlob(ctx, git.NewBlob{
+			GetTree(ctx, repositoryID, git.TreeSHA("masterTreeSHA")).
+			Return(&git.Tree{SHA: "masterTreeSHA"}, nil)
+		gitHub.EXPECT().
+			CreateBlob(mock.Anything, git.NewBlob{
 				Repository: repositoryID,
-				Content:    "base64content1",
+				Content:    io.NopCloser(strings.NewReader("content1")),
 			}).
 			Return(git.BlobSHA("blobSHA1"), nil)
 		gitHub.EXPECT().
-			CreateBlob(ctx, git.NewBlob{
+			CreateBlob(mock.Anything, git.NewBlob{
 				Repository: repositoryID,
-				Content:    "base64content2",
+				Content:    io.NopCloser(strings.NewReader("content2")),
 			}).
 			Return(git.BlobSHA("blobSHA2"), nil)
 		gitHub.EXPECT().
@@ -159,9 +185,9 @@ func TestCreateBlobTreeCommit_Do(t *testing.T) {
 			GitHub:     gitHub,
 		}
 		got, err := useCase.Do(ctx, Input{
-			Files: []fs.File{
-				{Path: "file1"},
-				{Path: "file2", Executable: true},
+			Files: []File{
+				{Path: "file1", Filename: "file1"},
+				{Path: "file2", Filename: "file2", Executable: true},
 			},
 			Repository:      repositoryID,
 			CommitMessage:   "message",
@@ -173,23 +199,59 @@ func TestCreateBlobTreeCommit_Do(t *testing.T) {
 			t.Fatalf("Do returned error: %+v", err)
 		}
 		want := &Output{
-			CommitSHA:    "commitSHA",
-			ChangedFiles: 1,
+			CommitSHA:     "commitSHA",
+			ChangedFiles:  1,
+			UploadedBlobs: map[string]git.BlobSHA{"file1": "blobSHA1", "file2": "blobSHA2"},
 		}
 		if diff := cmp.Diff(want, got); diff != "" {
 			t.Errorf("mismatch (-want +got):\n%s", diff)
 		}
 	})
 
-	t.Run("NoFile", func(t *testing.T) {
+	t.Run("DeletedFiles", func(t *testing.T) {
 
 		fileSystem := fs_mock.NewMockInterface(t)
+		fileSystem.EXPECT().
+			ComputeGitBlobSHA("file1").
+			Return("localBlobSHA1", nil)
+		fileSystem.EXPECT().
+			Open("file1").
+			Return(io.NopCloser(strings.NewReader("content1")), nil)
 
 		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			GetTree(ctx, repositoryID, git.TreeSHA("masterTreeSHA")).
+			Return(&git.Tree{
+				SHA: "masterTreeSHA",
+				Files: []git.File{
+					{Filename: "file2", BlobSHA: "blobSHA2"},
+				},
+			}, nil)
+		gitHub.EXPECT().
+			CreateBlob(mock.Anything, git.NewBlob{
+				Repository: repositoryID,
+				Content:    io.NopCloser(strings.NewReader("content1")),
+			}).
+			Return(git.BlobSHA("blobSHA1"), nil)
+		gitHub.EXPECT().
+			CreateTree(ctx, git.NewTree{
+				Repository:  repositoryID,
+				BaseTreeSHA: "masterTreeSHA",
+				Files: []git.File{
+					{
+						Filename: "file1",
+						BlobSHA:  "blobSHA1",
+					}, {
+						Filename: "file2",
+						Deleted:  true,
+					},
+				},
+			}).
+			Return(git.TreeSHA("treeSHA"), nil)
 		gitHub.EXPECT().
 			CreateCommit(ctx, git.NewCommit{
 				Repository:      repositoryID,
-				TreeSHA:         "masterTreeSHA",
+				TreeSHA:         "treeSHA",
 				ParentCommitSHA: "masterCommitSHA",
 				Message:         "message",
 			}).
@@ -200,7 +262,7 @@ func TestCreateBlobTreeCommit_Do(t *testing.T) {
 				CommitSHA:  "commitSHA",
 			}).
 			Return(&github.QueryCommitOutput{
-				ChangedFiles: 1,
+				ChangedFiles: 2,
 			}, nil)
 
 		useCase := CreateGitObject{
@@ -208,7 +270,10 @@ func TestCreateBlobTreeCommit_Do(t *testing.T) {
 			GitHub:     gitHub,
 		}
 		got, err := useCase.Do(ctx, Input{
-			Files:           nil,
+			Files: []File{
+				{Path: "file1", Filename: "file1"},
+			},
+			DeletedFiles:    []string{"file2", "file3"},
 			Repository:      repositoryID,
 			CommitMessage:   "message",
 			ParentCommitSHA: "masterCommitSHA",
@@ -218,27 +283,210 @@ func TestCreateBlobTreeCommit_Do(t *testing.T) {
 			t.Fatalf("Do returned error: %+v", err)
 		}
 		want := &Output{
-			CommitSHA:    "commitSHA",
-			ChangedFiles: 1,
+			CommitSHA:     "commitSHA",
+			ChangedFiles:  2,
+			DeletedFiles:  1,
+			UploadedBlobs: map[string]git.BlobSHA{"file1": "blobSHA1"},
 		}
 		if diff := cmp.Diff(want, got); diff != "" {
 			t.Errorf("mismatch (-want +got):\n%s", diff)
 		}
 	})
 
-	t.Run("CommitterAndAuthor", func(t *testing.T) {
+	t.Run("DeletedDirectoryInParentRepository", func(t *testing.T) {
+		parentRepositoryID := git.RepositoryID{Owner: "upstream", Name: "repo"}
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			GetTree(ctx, parentRepositoryID, git.TreeSHA("masterTreeSHA")).
+			Return(&git.Tree{
+				SHA: "masterTreeSHA",
+				Files: []git.File{
+					{Filename: "dir/file2", BlobSHA: "blobSHA2"},
+					{Filename: "dir/sub/file1", BlobSHA: "blobSHA1"},
+					{Filename: "dir2", BlobSHA: "blobSHA3"},
+				},
+			}, nil)
+		gitHub.EXPECT().
+			CreateTree(ctx, git.NewTree{
+				Repository:  repositoryID,
+				BaseTreeSHA: "masterTreeSHA",
+				Files: []git.File{
+					{Filename: "dir/file2", Deleted: true},
+					{Filename: "dir/sub/file1", Deleted: true},
+				},
+			}).
+			Return(git.TreeSHA("treeSHA"), nil)
+		gitHub.EXPECT().
+			CreateCommit(ctx, git.NewCommit{
+				Repository:      repositoryID,
+				TreeSHA:         "treeSHA",
+				ParentCommitSHA: "masterCommitSHA",
+				Message:         "message",
+			}).
+			Return(git.CommitSHA("commitSHA"), nil)
+		gitHub.EXPECT().
+			QueryCommit(ctx, github.QueryCommitInput{
+				Repository: repositoryID,
+				CommitSHA:  "commitSHA",
+			}).
+			Return(&github.QueryCommitOutput{
+				ChangedFiles: 2,
+			}, nil)
+
+		useCase := CreateGitObject{
+			FileSystem: fs_mock.NewMockInterface(t),
+			GitHub:     gitHub,
+		}
+		got, err := useCase.Do(ctx, Input{
+			DeletedFiles:     []string{"dir", "dir/sub/file1", "missing"},
+			Repository:       repositoryID,
+			CommitMessage:    "message",
+			ParentCommitSHA:  "masterCommitSHA",
+			ParentTreeSHA:    "masterTreeSHA",
+			ParentRepository: parentRepositoryID,
+		})
+		if err != nil {
+			t.Fatalf("Do returned error: %+v", err)
+		}
+		want := &Output{
+			CommitSHA:     "commitSHA",
+			ChangedFiles:  2,
+			DeletedFiles:  2,
+			UploadedBlobs: map[string]git.BlobSHA{},
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("UnchangedFiles", func(t *testing.T) {
 
 		fileSystem := fs_mock.NewMockInterface(t)
+		fileSystem.EXPECT().
+			ComputeGitBlobSHA("file1").
+			Return("blobSHA1", nil)
+		fileSystem.EXPECT().
+			ComputeGitBlobSHA("file2").
+			Return("blobSHA2", nil)
+		fileSystem.EXPECT().
+			ComputeGitBlobSHA("file3").
+			Return("localBlobSHA3", nil)
+		fileSystem.EXPECT().
+			Open("file3").
+			Return(io.NopCloser(strings.NewReader("content3")), nil)
 
 		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			GetTree(ctx, repositoryID, git.TreeSHA("masterTreeSHA")).
+			Return(&git.Tree{
+				SHA: "masterTreeSHA",
+				Files: []git.File{
+					{Filename: "file1", BlobSHA: "blobSHA1"},
+					{Filename: "file2", BlobSHA: "blobSHA2"},
+					{Filename: "file3", BlobSHA: "blobSHA3"},
+				},
+			}, nil)
+		gitHub.EXPECT().
+			CreateBlob(mock.Anything, git.NewBlob{
+				Repository: repositoryID,
+				Content:    io.NopCloser(strings.NewReader("content3")),
+			}).
+			Return(git.BlobSHA("localBlobSHA3"), nil)
+		gitHub.EXPECT().
+			CreateTree(ctx, git.NewTree{
+				Repository:  repositoryID,
+				BaseTreeSHA: "masterTreeSHA",
+				Files: []git.File{
+					{
+						Filename:   "file2",
+						BlobSHA:    "blobSHA2",
+						Executable: true,
+					}, {
+						Filename: "file3",
+						BlobSHA:  "localBlobSHA3",
+					},
+				},
+			}).
+			Return(git.TreeSHA("treeSHA"), nil)
 		gitHub.EXPECT().
 			CreateCommit(ctx, git.NewCommit{
 				Repository:      repositoryID,
-				TreeSHA:         "masterTreeSHA",
+				TreeSHA:         "treeSHA",
 				ParentCommitSHA: "masterCommitSHA",
 				Message:         "message",
-				Committer:       &git.CommitAuthor{Name: "SomeCommitter", Email: "committer@example.com"},
-				Author:          &git.CommitAuthor{Name: "SomeAuthor", Email: "author@example.com"},
+			}).
+			Return(git.CommitSHA("commitSHA"), nil)
+		gitHub.EXPECT().
+			QueryCommit(ctx, github.QueryCommitInput{
+				Repository: repositoryID,
+				CommitSHA:  "commitSHA",
+			}).
+			Return(&github.QueryCommitOutput{
+				ChangedFiles: 2,
+			}, nil)
+
+		useCase := CreateGitObject{
+			FileSystem: fileSystem,
+			GitHub:     gitHub,
+		}
+		got, err := useCase.Do(ctx, Input{
+			Files: []File{
+				{Path: "file1", Filename: "file1"},
+				{Path: "file2", Filename: "file2", Executable: true},
+				{Path: "file3", Filename: "file3"},
+			},
+			Repository:      repositoryID,
+			CommitMessage:   "message",
+			ParentCommitSHA: "masterCommitSHA",
+			ParentTreeSHA:   "masterTreeSHA",
+		})
+		if err != nil {
+			t.Fatalf("Do returned error: %+v", err)
+		}
+		want := &Output{
+			CommitSHA:     "commitSHA",
+			ChangedFiles:  2,
+			UploadedBlobs: map[string]git.BlobSHA{"file2": "blobSHA2", "file3": "localBlobSHA3"},
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("UploadedBlobs", func(t *testing.T) {
+
+		fileSystem := fs_mock.NewMockInterface(t)
+		fileSystem.EXPECT().
+			ComputeGitBlobSHA("file2").
+			Return("blobSHA2", nil)
+
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			GetTree(ctx, repositoryID, git.TreeSHA("newTreeSHA")).
+			Return(&git.Tree{
+				SHA: "newTreeSHA",
+				Files: []git.File{
+					{Filename: "file2", BlobSHA: "blobSHA2"},
+				},
+			}, nil)
+		gitHub.EXPECT().
+			CreateTree(ctx, git.NewTree{
+				Repository:  repositoryID,
+				BaseTreeSHA: "newTreeSHA",
+				Files: []git.File{
+					{
+						Filename: "file1",
+						BlobSHA:  "blobSHA1",
+					},
+				},
+			}).
+			Return(git.TreeSHA("treeSHA"), nil)
+		gitHub.EXPECT().
+			CreateCommit(ctx, git.NewCommit{
+				Repository:      repositoryID,
+				TreeSHA:         "treeSHA",
+				ParentCommitSHA: "newCommitSHA",
+				Message:         "message",
 			}).
 			Return(git.CommitSHA("commitSHA"), nil)
 		gitHub.EXPECT().
@@ -255,11 +503,81 @@ func TestCreateBlobTreeCommit_Do(t *testing.T) {
 			GitHub:     gitHub,
 		}
 		got, err := useCase.Do(ctx, Input{
-			Files:           nil,
+			Files: []File{
+				{Path: "file1", Filename: "file1"},
+				{Path: "file2", Filename: "file2"},
+			},
+			Repository:      repositoryID,
+			CommitMessage:   "message",
+			ParentCommitSHA: "newCommitSHA",
+			ParentTreeSHA:   "newTreeSHA",
+			UploadedBlobs:   map[string]git.BlobSHA{"file1": "blobSHA1"},
+		})
+		if err != nil {
+			t.Fatalf("Do returned error: %+v", err)
+		}
+		want := &Output{
+			CommitSHA:     "commitSHA",
+			ChangedFiles:  1,
+			UploadedBlobs: map[string]git.BlobSHA{"file1": "blobSHA1"},
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("BlobInRepository", func(t *testing.T) {
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			GetTree(ctx, repositoryID, git.TreeSHA("masterTreeSHA")).
+			Return(&git.Tree{
+				SHA: "masterTreeSHA",
+				Files: []git.File{
+					{Filename: "file2", BlobSHA: "blobSHA2"},
+				},
+			}, nil)
+		gitHub.EXPECT().
+			CreateTree(ctx, git.NewTree{
+				Repository:  repositoryID,
+				BaseTreeSHA: "masterTreeSHA",
+				Files: []git.File{
+					{
+						Filename:   "file1",
+						BlobSHA:    "blobSHA1",
+						Executable: true,
+					},
+				},
+			}).
+			Return(git.TreeSHA("treeSHA"), nil)
+		gitHub.EXPECT().
+			CreateCommit(ctx, git.NewCommit{
+				Repository:      repositoryID,
+				TreeSHA:         "treeSHA",
+				ParentCommitSHA: "masterCommitSHA",
+				Message:         "message",
+			}).
+			Return(git.CommitSHA("commitSHA"), nil)
+		gitHub.EXPECT().
+			QueryCommit(ctx, github.QueryCommitInput{
+				Repository: repositoryID,
+				CommitSHA:  "commitSHA",
+			}).
+			Return(&github.QueryCommitOutput{
+				ChangedFiles: 1,
+			}, nil)
+
+		// the local files are not read
+		useCase := CreateGitObject{
+			FileSystem: fs_mock.NewMockInterface(t),
+			GitHub:     gitHub,
+		}
+		got, err := useCase.Do(ctx, Input{
+			Files: []File{
+				{Path: "file1", Filename: "file1", Executable: true, BlobSHA: "blobSHA1"},
+				{Path: "file2", Filename: "file2", BlobSHA: "blobSHA2"},
+			},
 			Repository:      repositoryID,
 			CommitMessage:   "message",
-			Committer:       &git.CommitAuthor{Name: "SomeCommitter", Email: "committer@example.com"},
-			Author:          &git.CommitAuthor{Name: "SomeAuthor", Email: "author@example.com"},
 			ParentCommitSHA: "masterCommitSHA",
 			ParentTreeSHA:   "masterTreeSHA",
 		})
@@ -267,11 +585,494 @@ func TestCreateBlobTreeCommit_Do(t *testing.T) {
 			t.Fatalf("Do returned error: %+v", err)
 		}
 		want := &Output{
-			CommitSHA:    "commitSHA",
-			ChangedFiles: 1,
+			CommitSHA:     "commitSHA",
+			ChangedFiles:  1,
+			UploadedBlobs: map[string]git.BlobSHA{"file1": "blobSHA1"},
 		}
 		if diff := cmp.Diff(want, got); diff != "" {
 			t.Errorf("mismatch (-want +got):\n%s", diff)
 		}
 	})
+
+	t.Run("NothingChanged", func(t *testing.T) {
+
+		fileSystem := fs_mock.NewMockInterface(t)
+		fileSystem.EXPECT().
+			ComputeGitBlobSHA("file1").
+			Return("blobSHA1", nil)
+
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			GetTree(ctx, repositoryID, git.TreeSHA("masterTreeSHA")).
+			Return(&git.Tree{
+				SHA: "masterTreeSHA",
+				Files: []git.File{
+					{Filename: "file1", BlobSHA: "blobSHA1"},
+				},
+			}, nil)
+
+		useCase := CreateGitObject{
+			FileSystem: fileSystem,
+			GitHub:     gitHub,
+		}
+		got, err := useCase.Do(ctx, Input{
+			Files: []File{
+				{Path: "file1", Filename: "file1"},
+			},
+			DeletedFiles:    []string{"file2"},
+			Repository:      repositoryID,
+			CommitMessage:   "message",
+			ParentCommitSHA: "masterCommitSHA",
+			ParentTreeSHA:   "masterTreeSHA",
+		})
+		if err != nil {
+			t.Fatalf("Do returned error: %+v", err)
+		}
+		want := &Output{}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("NothingChangedInMergeCommit", func(t *testing.T) {
+		fileSystem := fs_mock.NewMockInterface(t)
+		fileSystem.EXPECT().
+			ComputeGitBlobSHA("file1").
+			Return("blobSHA1", nil)
+
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			GetTree(ctx, repositoryID, git.TreeSHA("masterTreeSHA")).
+			Return(&git.Tree{
+				SHA: "masterTreeSHA",
+				Files: []git.File{
+					{Filename: "file1", BlobSHA: "blobSHA1"},
+				},
+			}, nil)
+		gitHub.EXPECT().
+			CreateCommit(ctx, git.NewCommit{
+				Repository:      repositoryID,
+				Message:         "message",
+				ParentCommitSHA: "masterCommitSHA",
+				MergeParentSHAs: []git.CommitSHA{"releaseCommitSHA"},
+				TreeSHA:         "masterTreeSHA",
+			}).
+			Return("commitSHA", nil)
+		gitHub.EXPECT().
+			QueryCommit(ctx, github.QueryCommitInput{
+				Repository: repositoryID,
+				CommitSHA:  "commitSHA",
+			}).
+			Return(&github.QueryCommitOutput{}, nil)
+
+		useCase := CreateGitObject{
+			FileSystem: fileSystem,
+			GitHub:     gitHub,
+		}
+		got, err := useCase.Do(ctx, Input{
+			Files: []File{
+				{Path: "file1", Filename: "file1"},
+			},
+			Repository:      repositoryID,
+			CommitMessage:   "message",
+			ParentCommitSHA: "masterCommitSHA",
+			MergeParentSHAs: []git.CommitSHA{"releaseCommitSHA"},
+			ParentTreeSHA:   "masterTreeSHA",
+		})
+		if err != nil {
+			t.Fatalf("Do returned error: %+v", err)
+		}
+		want := &Output{CommitSHA: "commitSHA"}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("ParentTreeTruncated", func(t *testing.T) {
+
+		fileSystem := fs_mock.NewMockInterface(t)
+		fileSystem.EXPECT().
+			Open("file1").
+			Return(io.NopCloser(strings.NewReader("content1")), nil)
+
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			GetTree(ctx, repositoryID, git.TreeSHA("masterTreeSHA")).
+			Return(nil, fmt.Errorf("could not get the tree: %w", github.ErrTreeTruncated))
+		gitHub.EXPECT().
+			CreateBlob(mock.Anything, git.NewBlob{
+				Repository: repositoryID,
+				Content:    io.NopCloser(strings.NewReader("content1")),
+			}).
+			Return(git.BlobSHA("blobSHA1"), nil)
+		gitHub.EXPECT().
+			CreateTree(ctx, git.NewTree{
+				Repository:  repositoryID,
+				BaseTreeSHA: "masterTreeSHA",
+				Files: []git.File{
+					{Filename: "file1", BlobSHA: "blobSHA1"},
+					{Filename: "file2", Deleted: true},
+				},
+			}).
+			Return(git.TreeSHA("treeSHA"), nil)
+		gitHub.EXPECT().
+			CreateCommit(ctx, git.NewCommit{
+				Repository:      repositoryID,
+				TreeSHA:         "treeSHA",
+				ParentCommitSHA: "masterCommitSHA",
+				Message:         "message",
+			}).
+			Return(git.CommitSHA("commitSHA"), nil)
+		gitHub.EXPECT().
+			QueryCommit(ctx, github.QueryCommitInput{
+				Repository: repositoryID,
+				CommitSHA:  "commitSHA",
+			}).
+			Return(&github.QueryCommitOutput{
+				ChangedFiles: 2,
+			}, nil)
+
+		useCase := CreateGitObject{
+			FileSystem: fileSystem,
+			GitHub:     gitHub,
+		}
+		got, err := useCase.Do(ctx, Input{
+			Files: []File{
+				{Path: "file1", Filename: "file1"},
+			},
+			DeletedFiles:    []string{"file2"},
+			Repository:      repositoryID,
+			CommitMessage:   "message",
+			ParentCommitSHA: "masterCommitSHA",
+			ParentTreeSHA:   "masterTreeSHA",
+		})
+		if err != nil {
+			t.Fatalf("Do returned error: %+v", err)
+		}
+		want := &Output{
+			CommitSHA:     "commitSHA",
+			ChangedFiles:  2,
+			DeletedFiles:  1,
+			UploadedBlobs: map[string]git.BlobSHA{"file1": "blobSHA1"},
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("LFSFiles", func(t *testing.T) {
+		lfsObject1 := git.LFSObject{OID: "oid1", Size: 8}
+		lfsObject2 := git.LFSObject{OID: "oid2", Size: github.MaxBlobSize + 1}
+
+		fileSystem := fs_mock.NewMockInterface(t)
+		fileSystem.EXPECT().
+			ComputeSHA256("file1").
+			Return("oid1", nil)
+		fileSystem.EXPECT().
+			ComputeSHA256("file2").
+			Return("oid2", nil)
+		fileSystem.EXPECT().
+			Open("file2").
+			Return(io.NopCloser(strings.NewReader("content2")), nil)
+
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			GetTree(ctx, repositoryID, git.TreeSHA("masterTreeSHA")).
+			Return(&git.Tree{
+				SHA: "masterTreeSHA",
+				Files: []git.File{
+					{Filename: "file1", BlobSHA: lfsObject1.PointerBlobSHA()},
+				},
+			}, nil)
+		gitHub.EXPECT().
+			UploadLFSObject(mock.Anything, github.UploadLFSObjectInput{
+				Repository: repositoryID,
+				Object:     lfsObject2,
+				Content:    io.NopCloser(strings.NewReader("content2")),
+			}).
+			Return(nil)
+		gitHub.EXPECT().
+			CreateBlob(mock.Anything, git.NewBlob{
+				Repository: repositoryID,
+				Content:    strings.NewReader(lfsObject2.Pointer()),
+				Size:       int64(len(lfsObject2.Pointer())),
+			}).
+			Return(git.BlobSHA("pointerBlobSHA2"), nil)
+		gitHub.EXPECT().
+			CreateTree(ctx, git.NewTree{
+				Repository:  repositoryID,
+				BaseTreeSHA: "masterTreeSHA",
+				Files: []git.File{
+					{Filename: "file2", BlobSHA: "pointerBlobSHA2"},
+				},
+			}).
+			Return(git.TreeSHA("treeSHA"), nil)
+		gitHub.EXPECT().
+			CreateCommit(ctx, git.NewCommit{
+				Repository:      repositoryID,
+				TreeSHA:         "treeSHA",
+				ParentCommitSHA: "masterCommitSHA",
+				Message:         "message",
+			}).
+			Return(git.CommitSHA("commitSHA"), nil)
+		gitHub.EXPECT().
+			QueryCommit(ctx, github.QueryCommitInput{
+				Repository: repositoryID,
+				CommitSHA:  "commitSHA",
+			}).
+			Return(&github.QueryCommitOutput{
+				ChangedFiles: 1,
+			}, nil)
+
+		useCase := CreateGitObject{
+			FileSystem: fileSystem,
+			GitHub:     gitHub,
+		}
+		got, err := useCase.Do(ctx, Input{
+			Files: []File{
+				{Path: "file1", Filename: "file1", Size: lfsObject1.Size, LFS: true},
+				{Path: "file2", Filename: "file2", Size: lfsObject2.Size, LFS: true},
+			},
+			Repository:      repositoryID,
+			CommitMessage:   "message",
+			ParentCommitSHA: "masterCommitSHA",
+			ParentTreeSHA:   "masterTreeSHA",
+		})
+		if err != nil {
+			t.Fatalf("Do returned error: %+v", err)
+		}
+		want := &Output{
+			CommitSHA:     "commitSHA",
+			ChangedFiles:  1,
+			UploadedBlobs: map[string]git.BlobSHA{"file2": "pointerBlobSHA2"},
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("TooLargeFile", func(t *testing.T) {
+
+		fileSystem := fs_mock.NewMockInterface(t)
+		gitHub := github_mock.NewMockInterface(t)
+
+		useCase := CreateGitObject{
+			FileSystem: fileSystem,
+			GitHub:     gitHub,
+		}
+		got, err := useCase.Do(ctx, Input{
+			Files: []File{
+				{Path: "file1", Filename: "file1", Size: 8},
+				{Path: "file2", Filename: "file2", Size: github.MaxBlobSize + 1},
+			},
+			Repository:      repositoryID,
+			CommitMessage:   "message",
+			ParentCommitSHA: "masterCommitSHA",
+			ParentTreeSHA:   "masterTreeSHA",
+		})
+		if got != nil {
+			t.Errorf("got wants nil but %+v", got)
+		}
+		if err == nil {
+			t.Fatalf("err wants non-nil but nil")
+		}
+		if !strings.Contains(err.Error(), "file2") {
+			t.Errorf("err wants to contain file2 but %s", err)
+		}
+	})
+
+	t.Run("NoFile", func(t *testing.T) {
+
+		fileSystem := fs_mock.NewMockInterface(t)
+
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			CreateCommit(ctx, git.NewCommit{
+				Repository:      repositoryID,
+				TreeSHA:         "masterTreeSHA",
+				ParentCommitSHA: "masterCommitSHA",
+				Message:         "message",
+			}).
+			Return(git.CommitSHA("commitSHA"), nil)
+		gitHub.EXPECT().
+			QueryCommit(ctx, github.QueryCommitInput{
+				Repository: repositoryID,
+				CommitSHA:  "commitSHA",
+			}).
+			Return(&github.QueryCommitOutput{
+				ChangedFiles: 1,
+			}, nil)
+
+		useCase := CreateGitObject{
+			FileSystem: fileSystem,
+			GitHub:     gitHub,
+		}
+		got, err := useCase.Do(ctx, Input{
+			Files:           nil,
+			Repository:      repositoryID,
+			CommitMessage:   "message",
+			ParentCommitSHA: "masterCommitSHA",
+			ParentTreeSHA:   "masterTreeSHA",
+		})
+		if err != nil {
+			t.Fatalf("Do returned error: %+v", err)
+		}
+		want := &Output{
+			CommitSHA:    "commitSHA",
+			ChangedFiles: 1,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("CommitterAndAuthor", func(t *testing.T) {
+
+		fileSystem := fs_mock.NewMockInterface(t)
+
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			CreateCommit(ctx, git.NewCommit{
+				Repository:      repositoryID,
+				TreeSHA:         "masterTreeSHA",
+				ParentCommitSHA: "masterCommitSHA",
+				Message:         "message",
+				Committer:       &git.CommitAuthor{Name: "SomeCommitter", Email: "committer@example.com"},
+				Author:          &git.CommitAuthor{Name: "SomeAuthor", Email: "author@example.com"},
+			}).
+			Return(git.CommitSHA("commitSHA"), nil)
+		gitHub.EXPECT().
+			QueryCommit(ctx, github.QueryCommitInput{
+				Repository: repositoryID,
+				CommitSHA:  "commitSHA",
+			}).
+			Return(&github.QueryCommitOutput{
+				ChangedFiles: 1,
+			}, nil)
+
+		useCase := CreateGitObject{
+			FileSystem: fileSystem,
+			GitHub:     gitHub,
+		}
+		got, err := useCase.Do(ctx, Input{
+			Files:           nil,
+			Repository:      repositoryID,
+			CommitMessage:   "message",
+			Committer:       &git.CommitAuthor{Name: "SomeCommitter", Email: "committer@example.com"},
+			Author:          &git.CommitAuthor{Name: "SomeAuthor", Email: "author@example.com"},
+			ParentCommitSHA: "masterCommitSHA",
+			ParentTreeSHA:   "masterTreeSHA",
+		})
+		if err != nil {
+			t.Fatalf("Do returned error: %+v", err)
+		}
+		want := &Output{
+			CommitSHA:    "commitSHA",
+			ChangedFiles: 1,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
+
+func TestCreateGitObject_Do_Signature(t *testing.T) {
+	ctx := context.TODO()
+	repositoryID := git.RepositoryID{Owner: "owner", Name: "repo"}
+	date := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	author := &git.CommitAuthor{Name: "Alice", Email: "alice@example.com", Date: date}
+	signingKey := &git.SigningKey{Format: git.SignatureFormatSSH, Key: "id_ed25519"}
+	signedCommit := git.NewCommit{
+		Repository:      repositoryID,
+		Message:         "message",
+		Author:          author,
+		Committer:       author,
+		ParentCommitSHA: "masterCommitSHA",
+		TreeSHA:         "masterTreeSHA",
+		Signature:       "SIGNATURE",
+	}
+	payload, err := signedCommit.Payload()
+	if err != nil {
+		t.Fatalf("Payload returned error: %+v", err)
+	}
+	signedSHA, err := signedCommit.SHA()
+	if err != nil {
+		t.Fatalf("SHA returned error: %+v", err)
+	}
+	in := Input{
+		Repository:      repositoryID,
+		CommitMessage:   "message",
+		Author:          author,
+		ParentCommitSHA: "masterCommitSHA",
+		ParentTreeSHA:   "masterTreeSHA",
+		SigningKey:      signingKey,
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		commitSigner := signer_mock.NewMockInterface(t)
+		commitSigner.EXPECT().
+			Sign(ctx, *signingKey, payload).
+			Return("SIGNATURE", nil)
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			CreateCommit(ctx, signedCommit).
+			Return(signedSHA, nil)
+		gitHub.EXPECT().
+			QueryCommit(ctx, github.QueryCommitInput{
+				Repository: repositoryID,
+				CommitSHA:  signedSHA,
+			}).
+			Return(&github.QueryCommitOutput{
+				ChangedFiles: 0,
+			}, nil)
+
+		useCase := CreateGitObject{
+			FileSystem: fs_mock.NewMockInterface(t),
+			GitHub:     gitHub,
+			Signer:     commitSigner,
+		}
+		got, err := useCase.Do(ctx, in)
+		if err != nil {
+			t.Fatalf("Do returned error: %+v", err)
+		}
+		want := &Output{
+			CommitSHA: signedSHA,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("Mismatch", func(t *testing.T) {
+		commitSigner := signer_mock.NewMockInterface(t)
+		commitSigner.EXPECT().
+			Sign(ctx, *signingKey, payload).
+			Return("SIGNATURE", nil)
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			CreateCommit(ctx, signedCommit).
+			Return("anotherCommitSHA", nil)
+
+		useCase := CreateGitObject{
+			FileSystem: fs_mock.NewMockInterface(t),
+			GitHub:     gitHub,
+			Signer:     commitSigner,
+		}
+		if _, err := useCase.Do(ctx, in); err == nil {
+			t.Errorf("err wants non-nil but nil")
+		}
+	})
+
+	t.Run("NoAuthor", func(t *testing.T) {
+		useCase := CreateGitObject{
+			FileSystem: fs_mock.NewMockInterface(t),
+			GitHub:     github_mock.NewMockInterface(t),
+			Signer:     signer_mock.NewMockInterface(t),
+		}
+		in := in
+		in.Author = nil
+		if _, err := useCase.Do(ctx, in); err == nil {
+			t.Errorf("err wants non-nil but nil")
+		}
+	})
 }
@@ -0,0 +1,84 @@
+package gitobject
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/int128/ghcp/pkg/git"
+	"github.com/int128/ghcp/pkg/github"
+)
+
+// Changes represents the changes of the files against the parent tree.
+type Changes struct {
+	Added       []string // filenames which do not exist in the parent tree
+	Modified    []string // filenames whose content is changed
+	ModeChanged []string // filenames whose content is same but executable bit is changed
+	Deleted     []string // filenames which exist in the parent tree
+}
+
+// IsEmpty returns true if there is no change.
+func (c Changes) IsEmpty() bool {
+	return len(c.Added)+len(c.Modified)+len(c.ModeChanged)+len(c.Deleted) == 0
+}
+
+// Plan returns the changes which Do would make against the parent tree.
+// It computes the blob SHA of each file locally and calls only read APIs.
+// Author, committer, signing key and uploaded blobs of the input are ignored.
+func (u *CreateGitObject) Plan(ctx context.Context, in Input) (*Changes, error) {
+	if err := validateFileSizes(in.Files); err != nil {
+		return nil, err
+	}
+	parentFiles := make(map[string]git.File)
+	if in.ParentTreeSHA != "" && len(in.Files)+len(in.DeletedFiles) > 0 {
+		tree, err := u.GitHub.GetTree(ctx, in.parentRepository(), in.ParentTreeSHA)
+		if errors.Is(err, github.ErrTreeTruncated) {
+			return nil, fmt.Errorf("the parent tree %s is too large to compare", in.ParentTreeSHA)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not get the tree %s: %w", in.ParentTreeSHA, err)
+		}
+		for _, file := range tree.Files {
+			parentFiles[file.Filename] = file
+		}
+	}
+
+	var changes Changes
+	for _, file := range in.Files {
+		localBlobSHA, err := u.planBlobSHA(file)
+		if err != nil {
+			return nil, err
+		}
+		executable := !in.NoFileMode && file.Executable
+		parentFile, exists := parentFiles[file.Filename]
+		switch {
+		case !exists:
+			changes.Added = append(changes.Added, file.Filename)
+		case parentFile.BlobSHA != localBlobSHA:
+			changes.Modified = append(changes.Modified, file.Filename)
+		case parentFile.Executable != executable:
+			changes.ModeChanged = append(changes.ModeChanged, file.Filename)
+		default:
+			slog.Debug("Skip the file same as the parent tree", "file", file.Path, "filename", file.Filename)
+		}
+	}
+	changes.Deleted = expandDeletedFiles(in.DeletedFiles, parentFiles)
+	return &changes, nil
+}
+
+// planBlobSHA returns the blob SHA of the file without uploading it.
+func (u *CreateGitObject) planBlobSHA(file File) (git.BlobSHA, error) {
+	if file.BlobSHA != "" {
+		return file.BlobSHA, nil
+	}
+	upload := blobUpload{File: file}
+	if file.LFS {
+		lfsObject, err := u.computeLFSObject(file)
+		if err != nil {
+			return "", err
+		}
+		upload.lfsObject = lfsObject
+	}
+	return u.computeBlobSHA(upload)
+}
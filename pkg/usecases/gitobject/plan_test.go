@@ -0,0 +1,160 @@
+package gitobject
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/int128/ghcp/mocks/github.com/int128/ghcp/pkg/fs_mock"
+	"github.com/int128/ghcp/mocks/github.com/int128/ghcp/pkg/github_mock"
+	"github.com/int128/ghcp/pkg/git"
+	"github.com/int128/ghcp/pkg/github"
+)
+
+func TestCreateGitObject_Plan(t *testing.T) {
+	ctx := context.TODO()
+	repositoryID := git.RepositoryID{Owner: "owner", Name: "repo"}
+
+	t.Run("BasicOptions", func(t *testing.T) {
+		fileSystem := fs_mock.NewMockInterface(t)
+		fileSystem.EXPECT().
+			ComputeGitBlobSHA("file1").
+			Return("blobSHA1", nil)
+		fileSystem.EXPECT().
+			ComputeGitBlobSHA("file2").
+			Return("localBlobSHA2", nil)
+		fileSystem.EXPECT().
+			ComputeGitBlobSHA("file3").
+			Return("blobSHA3", nil)
+		fileSystem.EXPECT().
+			ComputeGitBlobSHA("file4").
+			Return("blobSHA4", nil)
+
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			GetTree(ctx, repositoryID, git.TreeSHA("masterTreeSHA")).
+			Return(&git.Tree{
+				SHA: "masterTreeSHA",
+				Files: []git.File{
+					{Filename: "file2", BlobSHA: "blobSHA2"},
+					{Filename: "file3", BlobSHA: "blobSHA3"},
+					{Filename: "file4", BlobSHA: "blobSHA4"},
+					{Filename: "file5", BlobSHA: "blobSHA5"},
+				},
+			}, nil)
+
+		useCase := CreateGitObject{
+			FileSystem: fileSystem,
+			GitHub:     gitHub,
+		}
+		got, err := useCase.Plan(ctx, Input{
+			Files: []File{
+				{Path: "file1", Filename: "file1"},
+				{Path: "file2", Filename: "file2"},
+				{Path: "file3", Filename: "file3", Executable: true},
+				{Path: "file4", Filename: "file4"},
+			},
+			DeletedFiles:    []string{"file5", "file6"},
+			Repository:      repositoryID,
+			ParentCommitSHA: "masterCommitSHA",
+			ParentTreeSHA:   "masterTreeSHA",
+		})
+		if err != nil {
+			t.Fatalf("Plan returned error: %+v", err)
+		}
+		want := &Changes{
+			Added:       []string{"file1"},
+			Modified:    []string{"file2"},
+			ModeChanged: []string{"file3"},
+			Deleted:     []string{"file5"},
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("NoParent", func(t *testing.T) {
+		fileSystem := fs_mock.NewMockInterface(t)
+		fileSystem.EXPECT().
+			ComputeGitBlobSHA("file1").
+			Return("blobSHA1", nil)
+
+		useCase := CreateGitObject{
+			FileSystem: fileSystem,
+			GitHub:     github_mock.NewMockInterface(t),
+		}
+		got, err := useCase.Plan(ctx, Input{
+			Files:      []File{{Path: "file1", Filename: "file1"}},
+			Repository: repositoryID,
+		})
+		if err != nil {
+			t.Fatalf("Plan returned error: %+v", err)
+		}
+		want := &Changes{Added: []string{"file1"}}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("DeletedFilesNotCleaned", func(t *testing.T) {
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			GetTree(ctx, repositoryID, git.TreeSHA("masterTreeSHA")).
+			Return(&git.Tree{
+				SHA: "masterTreeSHA",
+				Files: []git.File{
+					{Filename: "docs/a.md", BlobSHA: "blobSHA1"},
+					{Filename: "docs/b.md", BlobSHA: "blobSHA2"},
+					{Filename: "file3", BlobSHA: "blobSHA3"},
+				},
+			}, nil)
+
+		useCase := CreateGitObject{
+			FileSystem: fs_mock.NewMockInterface(t),
+			GitHub:     gitHub,
+		}
+		got, err := useCase.Plan(ctx, Input{
+			DeletedFiles:    []string{"docs/", "./file3", "../outside"},
+			Repository:      repositoryID,
+			ParentCommitSHA: "masterCommitSHA",
+			ParentTreeSHA:   "masterTreeSHA",
+		})
+		if err != nil {
+			t.Fatalf("Plan returned error: %+v", err)
+		}
+		want := &Changes{Deleted: []string{"docs/a.md", "docs/b.md", "file3"}}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("TreeTruncated", func(t *testing.T) {
+		gitHub := github_mock.NewMockInterface(t)
+		gitHub.EXPECT().
+			GetTree(ctx, repositoryID, git.TreeSHA("masterTreeSHA")).
+			Return(nil, github.ErrTreeTruncated)
+
+		useCase := CreateGitObject{
+			FileSystem: fs_mock.NewMockInterface(t),
+			GitHub:     gitHub,
+		}
+		_, err := useCase.Plan(ctx, Input{
+			Files:         []File{{Path: "file1", Filename: "file1"}},
+			Repository:    repositoryID,
+			ParentTreeSHA: "masterTreeSHA",
+		})
+		if err == nil {
+			t.Errorf("err wants non-nil but nil")
+		}
+	})
+}
+
+func TestExpandDeletedFiles(t *testing.T) {
+	t.Run("parent tree is not available", func(t *testing.T) {
+		got := expandDeletedFiles([]string{"docs/", "./file1", "/"}, nil)
+		want := []string{"docs", "file1"}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
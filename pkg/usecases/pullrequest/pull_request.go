@@ -17,7 +17,7 @@ var Set = wire.NewSet(
 )
 
 type Interface interface {
-	Do(ctx context.Context, in Input) error
+	Do(ctx context.Context, in Input) (*Output, error)
 }
 
 type Input struct {
@@ -31,17 +31,23 @@ type Input struct {
 	Draft          bool
 }
 
+// Output represents the result of the pull request.
+type Output struct {
+	URL     string
+	Created bool // false if an open pull request already exists
+}
+
 // PullRequest provides the use-case to create a pull request.
 type PullRequest struct {
 	GitHub github.Interface
 }
 
-func (u *PullRequest) Do(ctx context.Context, in Input) error {
+func (u *PullRequest) Do(ctx context.Context, in Input) (*Output, error) {
 	if !in.BaseRepository.IsValid() {
-		return errors.New("you must set the base repository")
+		return nil, errors.New("you must set the base repository")
 	}
 	if !in.HeadRepository.IsValid() {
-		return errors.New("you must set the head repository")
+		return nil, errors.New("you must set the head repository")
 	}
 
 	if in.HeadBranchName == "" || in.BaseBranchName == "" {
@@ -50,7 +56,7 @@ func (u *PullRequest) Do(ctx context.Context, in Input) error {
 			HeadRepository: in.HeadRepository,
 		})
 		if err != nil {
-			return fmt.Errorf("could not determine the default branch: %w", err)
+			return nil, fmt.Errorf("could not determine the default branch: %w", err)
 		}
 		if in.BaseBranchName == "" {
 			in.BaseBranchName = q.BaseDefaultBranchName
@@ -68,16 +74,16 @@ func (u *PullRequest) Do(ctx context.Context, in Input) error {
 		ReviewerUser:   in.Reviewer,
 	})
 	if err != nil {
-		return fmt.Errorf("could not query for creating a pull request: %w", err)
+		return nil, fmt.Errorf("could not query for creating a pull request: %w", err)
 	}
 	slog.Info("Logged in", "user", q.CurrentUserName)
 	if q.HeadBranchCommitSHA == "" {
-		return fmt.Errorf("the head branch (%s) does not exist", in.HeadBranchName)
+		return nil, fmt.Errorf("the head branch (%s) does not exist", in.HeadBranchName)
 	}
 	slog.Debug("Found the head branch", "branch", in.HeadBranchName, "commit", q.HeadBranchCommitSHA)
 	if len(q.ExistingPullRequests) > 0 {
 		slog.Info("An open pull request already exists", "url", q.ExistingPullRequests[0].URL)
-		return nil
+		return &Output{URL: q.ExistingPullRequests[0].URL}, nil
 	}
 	createdPR, err := u.GitHub.CreatePullRequest(ctx, github.CreatePullRequestInput{
 		BaseRepository:       in.BaseRepository,
@@ -90,19 +96,20 @@ func (u *PullRequest) Do(ctx context.Context, in Input) error {
 		Draft:                in.Draft,
 	})
 	if err != nil {
-		return fmt.Errorf("could not create a pull request: %w", err)
+		return nil, fmt.Errorf("could not create a pull request: %w", err)
 	}
 	slog.Info("Created a pull request", "url", createdPR.URL)
+	out := Output{URL: createdPR.URL, Created: true}
 
 	if in.Reviewer == "" {
-		return nil
+		return &out, nil
 	}
 	slog.Info("Requesting a review for pull request", "user", in.Reviewer)
 	if err := u.GitHub.RequestPullRequestReview(ctx, github.RequestPullRequestReviewInput{
 		PullRequest: createdPR.PullRequestNodeID,
 		User:        q.ReviewerUserNodeID,
 	}); err != nil {
-		return fmt.Errorf("could not request a review for the pull request: %w", err)
+		return nil, fmt.Errorf("could not request a review for the pull request: %w", err)
 	}
-	return nil
+	return &out, nil
 }
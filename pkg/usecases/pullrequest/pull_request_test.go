@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/int128/ghcp/mocks/github.com/int128/ghcp/pkg/github_mock"
 	"github.com/int128/ghcp/pkg/git"
 	"github.com/int128/ghcp/pkg/github"
@@ -49,8 +50,13 @@ func TestPullRequest_Do(t *testing.T) {
 			useCase := PullRequest{
 				GitHub: gitHub,
 			}
-			if err := useCase.Do(ctx, in); err != nil {
-				t.Errorf("err wants nil but %+v", err)
+			got, err := useCase.Do(ctx, in)
+			if err != nil {
+				t.Fatalf("err wants nil but %+v", err)
+			}
+			want := &Output{URL: "https://github.com/octocat/Spoon-Knife/pull/19445", Created: true}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
 			}
 		})
 		t.Run("when an open pull request already exists", func(t *testing.T) {
@@ -74,8 +80,13 @@ func TestPullRequest_Do(t *testing.T) {
 			useCase := PullRequest{
 				GitHub: gitHub,
 			}
-			if err := useCase.Do(ctx, in); err != nil {
-				t.Errorf("err wants nil but %+v", err)
+			got, err := useCase.Do(ctx, in)
+			if err != nil {
+				t.Fatalf("err wants nil but %+v", err)
+			}
+			want := &Output{URL: "https://github.com/octocat/Spoon-Knife/pull/19445"}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
 			}
 		})
 		t.Run("when the head branch does not exist", func(t *testing.T) {
@@ -93,7 +104,7 @@ func TestPullRequest_Do(t *testing.T) {
 			useCase := PullRequest{
 				GitHub: gitHub,
 			}
-			if err := useCase.Do(ctx, in); err == nil {
+			if _, err := useCase.Do(ctx, in); err == nil {
 				t.Errorf("err wants non-nil but got nil")
 			}
 		})
@@ -141,7 +152,7 @@ func TestPullRequest_Do(t *testing.T) {
 		useCase := PullRequest{
 			GitHub: gitHub,
 		}
-		if err := useCase.Do(ctx, in); err != nil {
+		if _, err := useCase.Do(ctx, in); err != nil {
 			t.Errorf("err wants nil but %+v", err)
 		}
 	})
@@ -190,7 +201,7 @@ func TestPullRequest_Do(t *testing.T) {
 		useCase := PullRequest{
 			GitHub: gitHub,
 		}
-		if err := useCase.Do(ctx, in); err != nil {
+		if _, err := useCase.Do(ctx, in); err != nil {
 			t.Errorf("err wants nil but %+v", err)
 		}
 	})
@@ -235,7 +246,7 @@ func TestPullRequest_Do(t *testing.T) {
 		useCase := PullRequest{
 			GitHub: gitHub,
 		}
-		if err := useCase.Do(ctx, in); err != nil {
+		if _, err := useCase.Do(ctx, in); err != nil {
 			t.Errorf("err wants nil but %+v", err)
 		}
 	})
@@ -19,7 +19,7 @@ var Set = wire.NewSet(
 )
 
 type Interface interface {
-	Do(ctx context.Context, in Input) error
+	Do(ctx context.Context, in Input) (*Output, error)
 }
 
 type Input struct {
@@ -27,43 +27,61 @@ type Input struct {
 	TagName                 git.TagName
 	TargetBranchOrCommitSHA string // optional
 	Paths                   []string
+	Include                 []string // glob patterns of the files to include (optional)
+	Exclude                 []string // glob patterns of the files to exclude (optional)
 	DryRun                  bool
 }
 
+// Output represents the result of the release.
+type Output struct {
+	Release        *git.Release // nil if the release does not exist due to dry-run
+	Created        bool
+	UploadedAssets []string // names of the uploaded assets
+}
+
 // Release create a release with the files to the tag in the repository.
 type Release struct {
 	FileSystem fs.Interface
 	GitHub     github.Interface
 }
 
-func (u *Release) Do(ctx context.Context, in Input) error {
+func (u *Release) Do(ctx context.Context, in Input) (*Output, error) {
 	if !in.Repository.IsValid() {
-		return errors.New("you must set GitHub repository")
+		return nil, errors.New("you must set GitHub repository")
 	}
 	if in.TagName == "" {
-		return errors.New("you must set the tag name")
+		return nil, errors.New("you must set the tag name")
 	}
 	if len(in.Paths) == 0 {
-		return errors.New("you must set one or more paths")
+		return nil, errors.New("you must set one or more paths")
 	}
 
-	files, err := u.FileSystem.FindFiles(in.Paths, nil)
+	var filter fs.FindFilesFilter
+	if len(in.Include) > 0 || len(in.Exclude) > 0 {
+		glob := fs.GlobFilter{Include: in.Include, Exclude: in.Exclude}
+		if err := glob.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid glob pattern: %w", err)
+		}
+		filter = glob
+	}
+	files, err := u.FileSystem.FindFiles(in.Paths, filter)
 	if err != nil {
-		return fmt.Errorf("could not find files: %w", err)
+		return nil, fmt.Errorf("could not find files: %w", err)
 	}
 	if len(files) == 0 {
-		return errors.New("no file exists in given paths")
+		return nil, errors.New("no file exists in given paths")
 	}
 
 	release, err := u.GitHub.GetReleaseByTagOrNil(ctx, in.Repository, in.TagName)
 	if err != nil {
-		return fmt.Errorf("could not get the release: %w", err)
+		return nil, fmt.Errorf("could not get the release: %w", err)
 	}
+	var out Output
 	if release == nil {
 		slog.Info("No release on the tag", "tag", in.TagName)
 		if in.DryRun {
 			slog.Info("Do not create a release due to dry-run")
-			return nil
+			return &out, nil
 		}
 		release, err = u.GitHub.CreateRelease(ctx, git.Release{
 			ID:              git.ReleaseID{Repository: in.Repository},
@@ -72,27 +90,32 @@ func (u *Release) Do(ctx context.Context, in Input) error {
 			TargetCommitish: in.TargetBranchOrCommitSHA,
 		})
 		if err != nil {
-			return fmt.Errorf("could not create a release: %w", err)
+			return nil, fmt.Errorf("could not create a release: %w", err)
 		}
 		slog.Info("Created a release", "release", release.Name)
+		out.Created = true
 	} else {
 		slog.Info("Found the release on the tag", "tag", in.TagName)
 	}
 
+	out.Release = release
+
 	if in.DryRun {
 		slog.Info("Do not upload files to the release due to dry-run", "release", release.Name)
-		return nil
+		return &out, nil
 	}
 	slog.Info("Uploading", "files", len(files))
 	for _, file := range files {
+		name := filepath.Base(file.Path)
 		if err := u.GitHub.CreateReleaseAsset(ctx, git.ReleaseAsset{
 			Release:  release.ID,
-			Name:     filepath.Base(file.Path),
+			Name:     name,
 			RealPath: file.Path,
 		}); err != nil {
-			return fmt.Errorf("could not create a release asset: %w", err)
+			return nil, fmt.Errorf("could not create a release asset: %w", err)
 		}
 		slog.Info("Uploaded", "file", file.Path)
+		out.UploadedAssets = append(out.UploadedAssets, name)
 	}
-	return nil
+	return &out, nil
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
 	"github.com/int128/ghcp/mocks/github.com/int128/ghcp/pkg/fs_mock"
 	"github.com/int128/ghcp/mocks/github.com/int128/ghcp/pkg/github_mock"
 	"github.com/int128/ghcp/pkg/fs"
@@ -76,8 +78,25 @@ func TestRelease_Do(t *testing.T) {
 			FileSystem: fileSystem,
 			GitHub:     gitHub,
 		}
-		if err := useCase.Do(ctx, in); err != nil {
-			t.Errorf("err wants nil but %+v", err)
+		got, err := useCase.Do(ctx, in)
+		if err != nil {
+			t.Fatalf("err wants nil but %+v", err)
+		}
+		want := &Output{
+			Release: &git.Release{
+				ID: git.ReleaseID{
+					Repository: targetRepositoryID,
+					InternalID: 1234567890,
+				},
+				TagName:         targetTagName,
+				Name:            targetTagName.Name(),
+				TargetCommitish: "TARGET_COMMIT",
+			},
+			Created:        true,
+			UploadedAssets: []string{"file1", "file2"},
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
 		}
 	})
 
@@ -125,7 +144,7 @@ func TestRelease_Do(t *testing.T) {
 			FileSystem: fileSystem,
 			GitHub:     gitHub,
 		}
-		if err := useCase.Do(ctx, in); err != nil {
+		if _, err := useCase.Do(ctx, in); err != nil {
 			t.Errorf("err wants nil but %+v", err)
 		}
 	})